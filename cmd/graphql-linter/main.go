@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/presentation"
 	log "github.com/sirupsen/logrus"
 )
@@ -10,8 +12,10 @@ var Version string
 func main() {
 	cliPresent := presentation.NewCLI(presentation.NewFlag(), Version)
 
-	err := cliPresent.Run()
+	exitCode, err := cliPresent.Run()
 	if err != nil {
-		log.WithError(err).Fatal("unable to run presentation layer")
+		log.WithError(err).Error("unable to run presentation layer")
 	}
+
+	os.Exit(exitCode)
 }
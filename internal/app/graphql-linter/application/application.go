@@ -1,39 +1,37 @@
 package application
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"slices"
 	"strings"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application/report"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
-	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
-	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/federation"
-	federation_rules "github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/federation/rules"
 	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/schubergphilis/graphql-linter/pkg/linter"
 	"github.com/schubergphilis/mcvs-golang-project-root/pkg/projectroot"
 	log "github.com/sirupsen/logrus"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/operationreport"
 )
 
-const (
-	linesAfterContext  = 3
-	linesBeforeContext = 2
-)
+// errMergeNestedConfig is returned when -merge would lint files that have a
+// configuration of their own, as the merged schema is linted with a single
+// configuration.
+var errMergeNestedConfig = errors.New("-merge cannot be used with nested configuration files")
 
 type Executor interface {
-	Run() error
+	Run() (Result, error)
 	Version()
 	PrintReport(
 		schemaFiles []string,
 		totalErrors int,
 		passedFiles int,
-		allErrors []models.DescriptionError,
+		allErrors []models.Finding,
 	)
 }
 
@@ -48,24 +46,45 @@ func NewDebug() Debug {
 }
 
 type Execute struct {
-	ConfigPath    string
-	Debugger      Debugger
-	TargetPath    string
-	Verbose       bool
-	VersionString string
+	BaselinePath      string
+	ConfigPath        string
+	Debugger          Debugger
+	Discovery         Discovery
+	Format            report.Format
+	Input             Input
+	Merge             bool
+	Outputs           []report.Output
+	TargetPath        string
+	Verbose           bool
+	VersionString     string
+	WriteBaselinePath string
+
+	stdinSource string
 }
 
 func NewExecute(
 	debugger Debugger,
 	configPath, targetPath, versionString string,
-	verbose bool,
+	format report.Format,
+	outputs []report.Output,
+	baselinePath, writeBaselinePath string,
+	discovery Discovery,
+	input Input,
+	merge, verbose bool,
 ) (Execute, error) {
 	execute := Execute{
-		ConfigPath:    configPath,
-		Debugger:      debugger,
-		TargetPath:    targetPath,
-		Verbose:       verbose,
-		VersionString: versionString,
+		BaselinePath:      baselinePath,
+		ConfigPath:        configPath,
+		Debugger:          debugger,
+		Discovery:         discovery,
+		Format:            format,
+		Input:             input,
+		Merge:             merge,
+		Outputs:           outputs,
+		TargetPath:        targetPath,
+		Verbose:           verbose,
+		VersionString:     versionString,
+		WriteBaselinePath: writeBaselinePath,
 	}
 
 	return execute, nil
@@ -75,50 +94,219 @@ func (Debug) ReadBuildInfo() (*debug.BuildInfo, bool) {
 	return debug.ReadBuildInfo()
 }
 
-func (e Execute) Run() error {
-	dataStore, err := data.NewStore(e.ConfigPath, e.TargetPath, rules.Rule{}, e.Verbose)
+// lintResult holds the findings of a run before the baseline is applied.
+type lintResult struct {
+	configs      fileConfigs
+	errorFiles   int
+	schemaErrors int
+	findings     []models.Finding
+	schemaFiles  []string
+	totalErrors  int
+}
+
+// Result is the outcome of a run that linted the schema files.
+type Result struct {
+	Summary report.Summary
+	// InvalidSchemas is the number of reported findings that fail the run
+	// because a schema could not be parsed or is not a valid GraphQL schema.
+	InvalidSchemas int
+}
+
+// Run lints the schema files and reports the findings. Findings do not make
+// Run return an error, the Result tells whether the run failed.
+func (e Execute) Run() (Result, error) {
+	result, err := e.lint()
+	if err != nil {
+		return Result{}, err
+	}
+
+	schemaFiles := result.schemaFiles
+	totalErrors := result.totalErrors
+	errorFilesCount := result.errorFiles
+	schemaErrors := result.schemaErrors
+	dataDescriptionError := result.findings
+
+	if e.BaselinePath != "" || e.WriteBaselinePath != "" {
+		dataDescriptionError, err = e.applyBaseline(dataDescriptionError)
+		if err != nil {
+			return Result{}, err
+		}
+
+		totalErrors = result.configs.countErrors(dataDescriptionError)
+		errorFilesCount = countErrorFiles(dataDescriptionError, schemaFiles, result.configs)
+		schemaErrors = result.configs.countSchemaErrors(dataDescriptionError)
+	}
+
+	summary := report.NewSummary(
+		schemaFiles,
+		totalErrors,
+		len(schemaFiles)-errorFilesCount,
+		dataDescriptionError,
+	)
+	summary.SchemaErrors = schemaErrors
+
+	for _, output := range e.Outputs {
+		err = report.WriteFile(output, summary, e.Version())
+		if err != nil {
+			return Result{}, fmt.Errorf("unable to write %s report to '%s': %w", output.Format, output.Path, err)
+		}
+
+		log.Debugf("wrote %s report to: %s", output.Format, output.Path)
+	}
+
+	runResult := Result{
+		Summary:        summary,
+		InvalidSchemas: result.configs.countInvalidSchemas(dataDescriptionError),
+	}
+
+	if e.Format == "" || e.Format == report.FormatText {
+		report.Print(summary)
+
+		return runResult, nil
+	}
+
+	err = report.Write(os.Stdout, e.Format, summary, e.Version())
+	if err != nil {
+		return Result{}, fmt.Errorf("unable to write %s report: %w", e.Format, err)
+	}
+
+	return runResult, nil
+}
+
+// lint lints every schema file and checks the suppressions of every
+// configuration that applies to them.
+func (e Execute) lint() (lintResult, error) {
+	dataStore, err := data.NewStore(e.ConfigPath, e.TargetPath, e.Verbose)
 	if err != nil {
-		return fmt.Errorf("unable to load new store: %w", err)
+		return lintResult{}, fmt.Errorf("unable to load new store: %w", err)
 	}
 
-	linterConfig, err := dataStore.LoadConfig()
+	configLoader, err := data.NewConfigLoader(e.ConfigPath)
 	if err != nil {
-		return fmt.Errorf("unable to load config: %w", err)
+		return lintResult{}, fmt.Errorf("unable to load config: %w", err)
+	}
+
+	linterConfig, err := configLoader.Root()
+	if err != nil {
+		return lintResult{}, fmt.Errorf("unable to load config: %w", err)
 	}
 
 	log.Debugf("linter config: %v", linterConfig)
 	dataStore.LinterConfig = linterConfig
+	e.Discovery = e.Discovery.withConfig(linterConfig)
+
+	e.stdinSource, err = e.readStdin()
+	if err != nil {
+		return lintResult{}, err
+	}
 
 	schemaFiles, err := e.FindAndLogGraphQLSchemaFiles()
 	if err != nil {
-		return fmt.Errorf("schema file discovery failed: %w", err)
+		return lintResult{}, fmt.Errorf("schema file discovery failed: %w", err)
 	}
 
+	sources := make([]linter.Source, 0, len(schemaFiles))
+	configs := make(fileConfigs, len(schemaFiles))
+	linterConfigs := make(publicConfigs)
+
 	for _, schemaFile := range schemaFiles {
-		schemaString, ok := dataStore.ReadAndValidateSchemaFile(schemaFile)
-		if !ok {
-			return fmt.Errorf("failed to read schema file: %s", schemaFile)
+		schemaString, readErr := e.readSchema(&dataStore, schemaFile)
+
+		if e.Verbose {
+			log.Infof("=== Linting %s ===", schemaFile)
 		}
 
-		filteredSchema := data.FilterSchemaComments(schemaString)
-		if !federation.ValidateFederationSchema(filteredSchema) {
-			return fmt.Errorf("federation validation failed for: %s", schemaFile)
+		source := linter.Source{Name: schemaFile, SDL: []byte(schemaString), Err: readErr}
+
+		fileConfig, err := configLoader.ForFile(schemaFile)
+		if err != nil {
+			return lintResult{}, fmt.Errorf("unable to load config for %s: %w", schemaFile, err)
 		}
+
+		configs[schemaFile] = fileConfig
+
+		if e.Merge {
+			// The findings about the merged schema as a whole belong to no
+			// file, and the configuration of the run applies to them.
+			configs[""] = linterConfig
+
+			if fileConfig != linterConfig {
+				return lintResult{}, fmt.Errorf("%w: %s applies to %s", errMergeNestedConfig, fileConfig.Path, schemaFile)
+			}
+		} else {
+			source.Config = linterConfigs.convert(fileConfig)
+		}
+
+		sources = append(sources, source)
 	}
 
-	totalErrors, errorFilesCount, dataDescriptionError := e.lintSchemaFiles(
-		linterConfig,
-		schemaFiles,
-	)
+	lint := linter.Lint
+	if e.Merge {
+		lint = linter.LintMerged
+	}
 
-	report.Print(
-		schemaFiles,
-		totalErrors,
-		len(schemaFiles)-errorFilesCount,
-		dataDescriptionError,
-	)
+	result, err := lint(context.Background(), sources, *linterConfigs.convert(linterConfig))
+	if err != nil {
+		return lintResult{}, fmt.Errorf("unable to lint: %w", err)
+	}
 
-	return nil
+	for _, finding := range result.Suppressed {
+		log.Debugf("SUPPRESSED: %s at line %d in %s", finding.Rule, finding.Line, finding.File)
+	}
+
+	for _, notice := range result.Notices {
+		log.Warn(notice.String())
+	}
+
+	for _, config := range configLoader.Configs() {
+		if config.Path != "" {
+			configs[config.Path] = config
+		}
+	}
+
+	findings := modelFindings(result.Findings)
+
+	return lintResult{
+		configs:      configs,
+		errorFiles:   countErrorFiles(findings, schemaFiles, configs),
+		schemaErrors: configs.countSchemaErrors(findings),
+		findings:     findings,
+		schemaFiles:  schemaFiles,
+		totalErrors:  result.Errors,
+	}, nil
+}
+
+// applyBaseline writes the findings to the -write-baseline file, if any, and
+// drops the findings that are recorded in the baseline, so that only new
+// findings are reported. Without -baseline, the baseline that has just been
+// written applies.
+func (e Execute) applyBaseline(findings []models.Finding) ([]models.Finding, error) {
+	baseline := data.NewBaseline(findings, filepath.Dir(e.WriteBaselinePath))
+
+	if e.WriteBaselinePath != "" {
+		err := data.WriteBaseline(e.WriteBaselinePath, baseline)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write baseline: %w", err)
+		}
+
+		log.Infof("wrote baseline with %d finding(s) to: %s", len(findings), e.WriteBaselinePath)
+	}
+
+	if e.BaselinePath != "" {
+		var err error
+
+		baseline, err = data.ReadBaseline(e.BaselinePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load baseline: %w", err)
+		}
+	}
+
+	newFindings, known := baseline.Filter(findings)
+	if known > 0 {
+		log.Infof("%d finding(s) are recorded in the baseline and are not reported", known)
+	}
+
+	return newFindings, nil
 }
 
 func (e Execute) Version() string {
@@ -139,17 +327,34 @@ func (e Execute) FindAndLogGraphQLSchemaFiles() ([]string, error) {
 		return nil, fmt.Errorf("failed to determine project root: %w", err)
 	}
 
-	if e.TargetPath == "" {
-		e.TargetPath = projectRoot
-	}
+	targets := e.targets(projectRoot)
+	schemaFiles := make([]string, 0, len(targets))
 
-	schemaFiles, err := findGraphQLFiles(e.TargetPath)
-	if err != nil {
-		return nil, fmt.Errorf("unable to find graphql files: %w", err)
+	for _, target := range targets {
+		if target == StdinPath {
+			schemaFiles = appendUnique(schemaFiles, e.Input.stdinName())
+
+			continue
+		}
+
+		var ignore *gitignore
+		if e.Discovery.Gitignore {
+			ignore, err = loadParentGitignores(projectRoot, target)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		targetFiles, err := findGraphQLFiles(target, e.Discovery, ignore)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find graphql files: %w", err)
+		}
+
+		schemaFiles = appendUnique(schemaFiles, targetFiles...)
 	}
 
 	if len(schemaFiles) == 0 {
-		return nil, fmt.Errorf("no GraphQL schema files found in directory: %s", e.TargetPath)
+		return nil, fmt.Errorf("no GraphQL schema files found in: %s", strings.Join(targets, ", "))
 	}
 
 	if e.Verbose {
@@ -163,27 +368,61 @@ func (e Execute) FindAndLogGraphQLSchemaFiles() ([]string, error) {
 	return schemaFiles, nil
 }
 
-func findGraphQLFiles(rootPath string) ([]string, error) {
+// appendUnique appends the paths that are not in paths yet, so that a file that
+// is given both directly and through its directory is linted once.
+func appendUnique(paths []string, newPaths ...string) []string {
+	for _, path := range newPaths {
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// findGraphQLFiles returns the files below rootPath that discovery includes.
+// With ignore, the .gitignore files below rootPath are honoured too.
+func findGraphQLFiles(rootPath string, discovery Discovery, ignore *gitignore) ([]string, error) {
+	err := discovery.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	var files []string
 
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+	err = filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if shouldSkip(info) {
+		relativePath := discoveryPath(rootPath, path)
+
+		if path != rootPath && shouldSkip(info) || isIgnoredDir(info) {
+			return skip(info)
+		}
+
+		if ignore != nil {
+			if ignore.ignored(path, info.IsDir()) {
+				return skip(info)
+			}
+
 			if info.IsDir() {
+				err = ignore.load(path)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if info.IsDir() {
+			if path != rootPath && (discovery.excluded(relativePath) || discovery.excluded(relativePath+"/")) {
 				return filepath.SkipDir
 			}
 
 			return nil
 		}
 
-		if isIgnoredDir(info) {
-			return filepath.SkipDir
-		}
-
-		if isGraphQLFile(info) {
+		if discovery.included(relativePath) {
 			files = append(files, path)
 		}
 
@@ -196,265 +435,148 @@ func findGraphQLFiles(rootPath string) ([]string, error) {
 	return files, nil
 }
 
-func shouldSkip(info os.FileInfo) bool {
-	return strings.HasPrefix(info.Name(), ".")
-}
-
-func isIgnoredDir(info os.FileInfo) bool {
-	if !info.IsDir() {
-		return false
+// discoveryPath returns the path that include and exclude patterns are matched
+// against: the path relative to the target path, or the path itself when the
+// target path is a file.
+func discoveryPath(rootPath, path string) string {
+	relativePath, err := filepath.Rel(rootPath, path)
+	if err != nil || relativePath == "." {
+		return filepath.ToSlash(path)
 	}
 
-	switch strings.ToLower(info.Name()) {
-	case "node_modules", "vendor", ".git":
-		return true
-	default:
-		return false
-	}
+	return filepath.ToSlash(relativePath)
 }
 
-func isGraphQLFile(info os.FileInfo) bool {
+func skip(info os.FileInfo) error {
 	if info.IsDir() {
-		return false
+		return filepath.SkipDir
 	}
 
-	ext := strings.ToLower(filepath.Ext(info.Name()))
-
-	return ext == ".graphql" || ext == ".graphqls"
+	return nil
 }
 
-func (e Execute) lintDescriptions(
-	doc *ast.Document,
-	modelsLinterConfig *models.LinterConfig,
-	schemaString string,
-	schemaPath string,
-) ([]models.DescriptionError, bool) {
-	dataStore, err := data.NewStore(e.ConfigPath, e.TargetPath, rules.Rule{}, e.Verbose)
-	if err != nil {
-		log.Errorf("unable to load new store: %v", err)
+// loadParentGitignores reads the .gitignore files from the project root down to
+// the parent of the target path, so that they apply to the target path too.
+func loadParentGitignores(projectRoot, targetPath string) (*gitignore, error) {
+	ignore := &gitignore{}
 
-		return nil, false
-	}
+	absRoot, rootErr := filepath.Abs(projectRoot)
+	absTarget, targetErr := filepath.Abs(targetPath)
 
-	descriptionErrors := make([]models.DescriptionError, 0, pkg_rules.DefaultErrorCapacity)
-	hasUnsuppressedDeprecationReasonError := false
+	if rootErr != nil || targetErr != nil {
+		return ignore, nil
+	}
 
-	descriptionErrors, hasUnsuppressedDeprecationReasonError = e.collectDescriptionErrors(
-		doc,
-		&dataStore,
-		modelsLinterConfig,
-		schemaString,
-		schemaPath,
-		descriptionErrors,
-		hasUnsuppressedDeprecationReasonError,
-	)
+	relative, err := filepath.Rel(absRoot, filepath.Dir(absTarget))
+	if err != nil || strings.HasPrefix(relative, "..") {
+		return ignore, nil
+	}
 
-	enumSortErrors := dataStore.Ruler.EnumValuesSortedAlphabetically(
-		doc,
-		modelsLinterConfig,
-		schemaString,
-		schemaPath,
-	)
-	descriptionErrors = append(descriptionErrors, enumSortErrors...)
+	dir := absRoot
 
-	return sortDescriptionErrors(descriptionErrors), hasUnsuppressedDeprecationReasonError
-}
+	for _, part := range append([]string{""}, strings.Split(relative, string(filepath.Separator))...) {
+		if part == "." {
+			continue
+		}
 
-func (e Execute) collectDescriptionErrors(
-	doc *ast.Document,
-	dataStore *data.Store,
-	modelsLinterConfig *models.LinterConfig,
-	schemaString string,
-	schemaPath string,
-	descriptionErrors []models.DescriptionError,
-	hasUnsuppressedDeprecationReasonError bool,
-) ([]models.DescriptionError, bool) {
-	helpers := []func(*ast.Document, string) []models.DescriptionError{
-		dataStore.Ruler.FieldsAreCamelCased,
-		dataStore.Ruler.InputObjectFieldsSortedAlphabetically,
-		dataStore.Ruler.InputObjectValuesCamelCased,
-		dataStore.Ruler.MissingArgumentDescriptions,
-		dataStore.Ruler.MissingDeprecationReasons,
-		dataStore.Ruler.MissingEnumValueDescriptions,
-		dataStore.Ruler.MissingFieldDescriptions,
-		dataStore.Ruler.MissingInputObjectValueDescriptions,
-		dataStore.Ruler.MissingQueryRootType,
-		dataStore.Ruler.MissingTypeDescriptions,
-		dataStore.Ruler.RelayConnectionArgumentsSpec,
-		dataStore.Ruler.RelayConnectionTypesSpec,
-		dataStore.Ruler.RelayPageInfoSpec,
-		dataStore.Ruler.TypesAreCapitalized,
-		dataStore.UncapitalizedDescriptions,
-		dataStore.UnsortedInterfaceFields,
-		dataStore.UnsortedTypeFields,
-		dataStore.Ruler.UnusedTypes,
-	}
-	for _, helper := range helpers {
-		errList := helper(doc, schemaString)
-		for _, err := range errList {
-			descriptionErrors = append(descriptionErrors, err)
-			if strings.Contains(err.Message, "deprecations-have-a-reason") {
-				rule := err.Message
-				if idx := strings.Index(rule, ":"); idx != -1 {
-					rule = rule[:idx]
-				}
+		dir = filepath.Join(dir, part)
 
-				if !pkg_rules.IsSuppressedNoValue(schemaPath, err.LineNum, modelsLinterConfig, rule) {
-					hasUnsuppressedDeprecationReasonError = true
-				}
-			}
+		err = ignore.load(dir)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return descriptionErrors, hasUnsuppressedDeprecationReasonError
+	return ignore, nil
 }
 
-func sortDescriptionErrors(errors []models.DescriptionError) []models.DescriptionError {
-	return errors
+func shouldSkip(info os.FileInfo) bool {
+	return strings.HasPrefix(info.Name(), ".")
 }
 
-func getUnsuppressedDescriptionErrors(
-	descriptionErrors []models.DescriptionError,
-	modelsLinterConfig *models.LinterConfig,
-	schemaFile string,
-) []models.DescriptionError {
-	unsuppressed := make([]models.DescriptionError, 0, len(descriptionErrors))
-	for _, err := range descriptionErrors {
-		rule := err.Message
-		if idx := strings.Index(rule, ":"); idx != -1 {
-			rule = rule[:idx]
-		}
-
-		if !pkg_rules.IsSuppressedNoValue(schemaFile, err.LineNum, modelsLinterConfig, rule) {
-			unsuppressed = append(unsuppressed, err)
-		}
+func isIgnoredDir(info os.FileInfo) bool {
+	if !info.IsDir() {
+		return false
 	}
 
-	return unsuppressed
+	switch strings.ToLower(info.Name()) {
+	case "node_modules", "vendor", ".git":
+		return true
+	default:
+		return false
+	}
 }
 
-func (e Execute) lintSchemaFiles(
-	modelsLinterConfig *models.LinterConfig,
-	schemaFiles []string,
-) (int, int, []models.DescriptionError) {
-	totalErrors := 0
-	errorFilesCount := 0
+// fileConfigs maps schema files, and the configuration files that findings
+// about suppressions point at, to the configuration that applies to them.
+type fileConfigs map[string]*models.LinterConfig
 
-	var allErrors []models.DescriptionError
+// countErrors returns the number of findings that fail the run according to
+// the configuration of their file.
+func (c fileConfigs) countErrors(findings []models.Finding) int {
+	count := 0
 
-	for _, schemaFile := range schemaFiles {
-		errCount, fileErrCount, fileErrors := e.lintSingleSchemaFile(modelsLinterConfig, schemaFile)
-		totalErrors += errCount
-		errorFilesCount += fileErrCount
-
-		allErrors = append(allErrors, fileErrors...)
+	for _, finding := range findings {
+		if c[finding.FilePath].Fails(finding) {
+			count++
+		}
 	}
 
-	return totalErrors, errorFilesCount, allErrors
+	return count
 }
 
-func (e Execute) lintSingleSchemaFile(
-	modelsLinterConfig *models.LinterConfig,
-	schemaFile string,
-) (
-	int,
-	int,
-	[]models.DescriptionError,
-) {
-	if e.Verbose {
-		log.Infof("=== Linting %s ===", schemaFile)
-	}
-
-	dataStore, err := data.NewStore(e.ConfigPath, e.TargetPath, rules.Rule{}, e.Verbose)
-	if err != nil {
-		log.Errorf("unable to load new store: %v", err)
-	}
+// countInvalidSchemas returns the number of findings that fail the run and
+// mean that a schema is not valid, see schemaRules.
+func (c fileConfigs) countInvalidSchemas(findings []models.Finding) int {
+	count := 0
 
-	schemaString, ok := dataStore.ReadAndValidateSchemaFile(schemaFile)
-	if !ok {
-		return 1, 1, []models.DescriptionError{{
-			FilePath:    schemaFile,
-			LineNum:     0,
-			Message:     "failed-to-read-schema-file: failed to read schema file",
-			LineContent: "",
-		}}
+	for _, finding := range findings {
+		if schemaRules[finding.RuleID] && c[finding.FilePath].Fails(finding) {
+			count++
+		}
 	}
 
-	_, doc, parseReport := dataStore.ParseAndFilterSchema(schemaString)
-	LogSchemaParseErrors(schemaString, &parseReport)
-
-	totalErrors, errorFilesCount, allErrors := e.collectLintErrors(
-		&doc,
-		modelsLinterConfig,
-		schemaString,
-		schemaFile,
-		&dataStore,
-	)
-
-	return totalErrors, errorFilesCount, allErrors
+	return count
 }
 
-func LogSchemaParseErrors(
-	schemaString string,
-	parseReport *operationreport.Report,
-) {
-	if !parseReport.HasErrors() {
-		return
-	}
+// countSchemaErrors returns the number of findings that fail the run and belong
+// to no file, such as the findings about a merged schema as a whole. They do
+// not count against any schema file, see countErrorFiles.
+func (c fileConfigs) countSchemaErrors(findings []models.Finding) int {
+	count := 0
 
-	log.Errorf("Failed to parse schema - found %d errors:\n",
-		len(parseReport.InternalErrors)+len(parseReport.ExternalErrors))
+	for _, finding := range findings {
+		if finding.FilePath == "" && c[finding.FilePath].Fails(finding) {
+			count++
+		}
+	}
 
-	report.InternalErrors(parseReport)
-	report.ExternalErrors(schemaString, parseReport, linesBeforeContext, linesAfterContext)
+	return count
 }
 
-func parseGraphQLDocument(schemaContent string) *ast.Document {
-	doc, _ := astparser.ParseGraphqlDocumentString(schemaContent)
+// countErrorFiles returns the number of schema files with at least one finding
+// that fails the run.
+func countErrorFiles(findings []models.Finding, schemaFiles []string, configs fileConfigs) int {
+	isSchemaFile := make(map[string]bool, len(schemaFiles))
+	for _, schemaFile := range schemaFiles {
+		isSchemaFile[schemaFile] = true
+	}
 
-	return &doc
-}
+	errorFiles := make(map[string]bool)
 
-func (e Execute) collectLintErrors(
-	doc *ast.Document,
-	modelsLinterConfig *models.LinterConfig,
-	schemaString string,
-	schemaFile string,
-	dataStore *data.Store,
-) (int, int, []models.DescriptionError) {
-	descriptionErrors, hasUnsuppressedDeprecationReasonError := e.lintDescriptions(
-		doc,
-		modelsLinterConfig,
-		schemaString,
-		schemaFile,
-	)
-	unsuppressedDescriptionErrors := getUnsuppressedDescriptionErrors(
-		descriptionErrors,
-		modelsLinterConfig,
-		schemaFile,
-	)
-	unsuppressedDataTypeErrors, dataTypeErrors := dataStore.CollectUnsuppressedDataTypeErrors(
-		doc,
-		modelsLinterConfig,
-		schemaString,
-		schemaFile,
-	)
-	allErrors := append([]models.DescriptionError{}, dataTypeErrors...)
-	unsuppressedDirectiveOrFederationError := !federation_rules.ValidateDirectiveNames(doc)
-
-	totalErrors, errorFilesCount := report.SummarizeLintResults(
-		len(unsuppressedDescriptionErrors),
-		hasUnsuppressedDeprecationReasonError,
-		unsuppressedDataTypeErrors,
-		unsuppressedDirectiveOrFederationError,
-	)
-	if totalErrors > 0 {
-		for i := range unsuppressedDescriptionErrors {
-			unsuppressedDescriptionErrors[i].FilePath = schemaFile
+	for _, finding := range findings {
+		if isSchemaFile[finding.FilePath] && configs[finding.FilePath].Fails(finding) {
+			errorFiles[finding.FilePath] = true
 		}
-
-		allErrors = append(allErrors, unsuppressedDescriptionErrors...)
 	}
 
-	return totalErrors, errorFilesCount, allErrors
+	return len(errorFiles)
+}
+
+// schemaRules are the rules whose findings mean that a schema could not be
+// parsed or is not a valid GraphQL schema, rather than that it breaks a
+// convention.
+var schemaRules = map[string]bool{
+	pkg_rules.RuleFederationCompositionError: true,
+	pkg_rules.RuleInvalidGraphQLSchema:       true,
 }
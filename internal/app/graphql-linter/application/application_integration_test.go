@@ -69,10 +69,83 @@ func TestFindGraphQLFiles(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := findGraphQLFiles(tmpDir)
+			got, err := findGraphQLFiles(tmpDir, Discovery{}, nil)
 			require.NoError(t, err)
 
 			assert.ElementsMatch(t, test.expectFiles, got)
 		})
 	}
 }
+
+func TestFindGraphQLFiles_Discovery(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	for _, rel := range []string{
+		"schema/a.graphqls",
+		"schema/b.gql",
+		"schema/__generated__/c.graphqls",
+		"fixtures/d.graphqls",
+		"build/e.graphqls",
+		"build/keep.graphqls",
+	} {
+		createTestFile(t, tmpDir, rel)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("# output\nbuild/*\n!keep.graphqls\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		discovery   Discovery
+		gitignore   bool
+		expectFiles []string
+	}{
+		{
+			name:      "default include",
+			discovery: Discovery{},
+			expectFiles: []string{
+				"build/e.graphqls",
+				"build/keep.graphqls",
+				"fixtures/d.graphqls",
+				"schema/__generated__/c.graphqls",
+				"schema/a.graphqls",
+			},
+		},
+		{
+			name: "include and exclude",
+			discovery: Discovery{
+				Include: []string{"**/*.graphqls", "**/*.gql"},
+				Exclude: []string{"**/__generated__/**", "fixtures", "build/"},
+			},
+			expectFiles: []string{"schema/a.graphqls", "schema/b.gql"},
+		},
+		{
+			name:        "gitignore",
+			discovery:   Discovery{Exclude: []string{"schema/"}},
+			gitignore:   true,
+			expectFiles: []string{"build/keep.graphqls", "fixtures/d.graphqls"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ignore *gitignore
+			if test.gitignore {
+				ignore = &gitignore{}
+			}
+
+			got, err := findGraphQLFiles(tmpDir, test.discovery, ignore)
+			require.NoError(t, err)
+
+			want := make([]string, 0, len(test.expectFiles))
+			for _, rel := range test.expectFiles {
+				want = append(want, filepath.Join(tmpDir, rel))
+			}
+
+			assert.Equal(t, want, got)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package application
 
 import (
-	"os"
+	"path/filepath"
 	"reflect"
 	"runtime/debug"
 	"testing"
@@ -9,9 +9,9 @@ import (
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application/mocks"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application/report"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
-	ruler_mocks "github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules/mocks"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
 	"github.com/stretchr/testify/assert"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExecute_Version(t *testing.T) {
@@ -72,36 +72,29 @@ func TestErrorTypeCounts(t *testing.T) {
 
 	tests := []struct {
 		name  string
-		input []models.DescriptionError
+		input []models.Finding
 		want  map[string]int
 	}{
 		{
 			name:  "empty slice",
-			input: []models.DescriptionError{},
+			input: []models.Finding{},
 			want:  map[string]int{},
 		},
 		{
-			name:  "single error with colon",
-			input: []models.DescriptionError{{Message: "type-error: something went wrong"}},
-			want:  map[string]int{"type-error": 1},
+			name:  "single finding",
+			input: []models.Finding{{RuleID: "types-have-descriptions", Message: "something went wrong"}},
+			want:  map[string]int{"types-have-descriptions": 1},
 		},
 		{
-			name:  "single error with space",
-			input: []models.DescriptionError{{Message: "field error something went wrong"}},
-			want:  map[string]int{"field": 1},
-		},
-		{
-			name: "multiple errors, mixed",
-			input: []models.DescriptionError{
-				{Message: "type-error: foo"},
-				{Message: "type-error: bar"},
-				{Message: "field error baz"},
-				{Message: "other"},
+			name: "multiple findings",
+			input: []models.Finding{
+				{RuleID: "types-have-descriptions", Message: "foo"},
+				{RuleID: "types-have-descriptions", Message: "bar"},
+				{RuleID: "fields-have-descriptions", Message: "baz"},
 			},
 			want: map[string]int{
-				"type-error": 2,
-				"field":      1,
-				"other":      1,
+				"types-have-descriptions":  2,
+				"fields-have-descriptions": 1,
 			},
 		},
 	}
@@ -118,150 +111,112 @@ func TestErrorTypeCounts(t *testing.T) {
 	}
 }
 
-func TestLintDescriptions(t *testing.T) {
+func TestFindAndLogGraphQLSchemaFiles(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name                          string
-		errorSubstring                string
-		schemaContent                 string
-		setupMockRuler                func(*ruler_mocks.Ruler)
-		wantNumberOfDescriptionErrors int
-		wantHasDeprecationReasonError bool
-	}{
-		{
-			name:                          "missing Query root type",
-			errorSubstring:                "invalid-graphql-schema",
-			schemaContent:                 "type User { id: ID }",
-			wantNumberOfDescriptionErrors: 5,
-			wantHasDeprecationReasonError: false,
-		},
-		{
-			name:                          "all valid, no description reason errors",
-			errorSubstring:                "Object type 'Query' is missing a description",
-			schemaContent:                 "type Query { id: ID }",
-			wantNumberOfDescriptionErrors: 3,
-			wantHasDeprecationReasonError: false,
-		},
-		{
-			name:                          "missing deprecation reason",
-			errorSubstring:                "deprecations-have-a-reason",
-			schemaContent:                 `enum Status {\n  ACTIVE\n  INACTIVE @deprecated\n}`,
-			wantNumberOfDescriptionErrors: 10,
-			wantHasDeprecationReasonError: true,
-		},
-		{
-			name:                          "missing type description",
-			errorSubstring:                "Object type 'Foo' is missing a description",
-			schemaContent:                 "type Query { id: ID }\ntype Foo { bar: String }",
-			wantNumberOfDescriptionErrors: 6,
-			wantHasDeprecationReasonError: false,
-		},
+	files := map[string]string{
+		"test.graphql": "type Query { id: ID }",
 	}
+	dir := createTestDirectory(t, files)
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
+	e := Execute{TargetPath: dir, Verbose: false}
 
-			runLintDescriptionsTest(
-				t,
-				&models.LinterConfig{},
-				test.name,
-				test.schemaContent,
-				test.errorSubstring,
-				test.wantHasDeprecationReasonError,
-				test.wantNumberOfDescriptionErrors,
-			)
-		})
+	foundFiles, err := e.FindAndLogGraphQLSchemaFiles()
+	if err != nil || len(foundFiles) != 1 {
+		t.Errorf("expected 1 graphql file, got %v, err %v", foundFiles, err)
 	}
 }
 
-func TestValidateDataTypes(t *testing.T) {
+func TestExecute_Run_ReturnsResult(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name          string
-		schemaContent string
-		wantValid     bool
-		wantErrLines  int
-	}{
-		{
-			name:          "valid types",
-			schemaContent: "type Query { id: ID name: String }",
-			wantValid:     true,
-			wantErrLines:  0,
-		},
-		{
-			name:          "undefined type",
-			schemaContent: "type Query { foo: Bar }",
-			wantValid:     false,
-			wantErrLines:  1,
-		},
-		{
-			name:          "valid enum",
-			schemaContent: "enum Status { ACTIVE INACTIVE } type Query { status: Status }",
-			wantValid:     true,
-			wantErrLines:  0,
-		},
-		{
-			name:          "input with undefined type",
-			schemaContent: "input FooInput { bar: Baz } type Query { foo(input: FooInput): String }",
-			wantValid:     false,
-			wantErrLines:  1,
-		},
-	}
+	dir := createTestDirectory(t, map[string]string{
+		".graphql-linter.yml": "settings:\n  validateFederation: false\n",
+		"broken.graphqls":     "type Query {\n  name String\n}\n",
+	})
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			runValidateDataTypesTest(
-				t,
-				nil,
-				test.name,
-				test.schemaContent,
-				test.wantValid,
-				test.wantErrLines,
-			)
-		})
-	}
+	result, err := Execute{
+		ConfigPath: filepath.Join(dir, ".graphql-linter.yml"),
+		TargetPath: dir,
+	}.Run()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.InvalidSchemas)
+	assert.Positive(t, result.Summary.TotalErrors)
+	assert.Equal(t, 1, result.Summary.FilesWithAtLeastOneError)
 }
 
-func TestFindAndLogGraphQLSchemaFiles(t *testing.T) {
+// TestExecute_Run_MissingQueryRootType checks that a schema without a Query
+// root type breaks a rule, but is still a valid schema.
+func TestExecute_Run_MissingQueryRootType(t *testing.T) {
 	t.Parallel()
 
-	files := map[string]string{
-		"test.graphql": "type Query { id: ID }",
-	}
-	dir := createTestDirectory(t, files)
+	dir := createTestDirectory(t, map[string]string{
+		".graphql-linter.yml": "settings:\n  validateFederation: false\n",
+		"foo.graphqls":        "type Foo { a: String }\n",
+	})
 
-	e := Execute{TargetPath: dir, Verbose: false}
+	result, err := Execute{
+		ConfigPath: filepath.Join(dir, ".graphql-linter.yml"),
+		TargetPath: dir,
+	}.Run()
+	require.NoError(t, err)
 
-	foundFiles, err := e.FindAndLogGraphQLSchemaFiles()
-	if err != nil || len(foundFiles) != 1 {
-		t.Errorf("expected 1 graphql file, got %v, err %v", foundFiles, err)
-	}
+	assert.Equal(t, 0, result.InvalidSchemas)
+	assert.Positive(t, result.Summary.TotalErrors)
 }
 
-func TestLintSchemaFiles(t *testing.T) {
+// TestExecute_Run_MergedSchemaErrors checks that the findings about a merged
+// schema as a whole are counted apart from the files, which all passed.
+func TestExecute_Run_MergedSchemaErrors(t *testing.T) {
 	t.Parallel()
 
-	dir := t.TempDir()
-	file := dir + "/test.graphql"
+	dir := createTestDirectory(t, map[string]string{
+		".graphql-linter.yml": "settings:\n  validateFederation: false\n",
+		"query.graphqls":      "\"\"\"Query root.\"\"\"\ntype Query {\n  \"\"\"The identifier.\"\"\"\n  id: ID\n}\n",
+	})
+
+	result, err := Execute{
+		ConfigPath: filepath.Join(dir, ".graphql-linter.yml"),
+		TargetPath: dir,
+		Merge:      true,
+	}.Run()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Summary.TotalErrors)
+	assert.Equal(t, 1, result.Summary.SchemaErrors)
+	assert.Equal(t, 1, result.Summary.PassedFiles)
+}
 
-	schema := `"""Query root"""
-type Query { """ID field""" id: ID }`
+func TestCountSchemaErrors(t *testing.T) {
+	t.Parallel()
 
-	err := os.WriteFile(file, []byte(schema), 0o600)
-	if err != nil {
-		t.Fatalf("failed to write test file: %v", err)
+	findings := []models.Finding{
+		{RuleID: pkg_rules.RuleRelayPageInfoSpec, Severity: models.SeverityError},
+		{RuleID: pkg_rules.RuleMissingQueryRootType, Severity: models.SeverityWarning},
+		{FilePath: "a.graphqls", RuleID: pkg_rules.RuleTypesHaveDescriptions, Severity: models.SeverityError},
 	}
 
-	e := createTestExecute(false)
+	assert.Equal(t, 1, fileConfigs{}.countSchemaErrors(findings))
+
+	strict := fileConfigs{"": {Settings: models.Settings{StrictMode: true}}}
+	assert.Equal(t, 2, strict.countSchemaErrors(findings))
+}
+
+func TestCountInvalidSchemas(t *testing.T) {
+	t.Parallel()
 
-	total, errorFiles, _ := e.lintSchemaFiles(&models.LinterConfig{}, []string{file})
-	if total != 1 || errorFiles != 1 {
-		t.Errorf("expected 1 error, got %d, errorFiles %d", total, errorFiles)
+	findings := []models.Finding{
+		{FilePath: "a.graphqls", RuleID: pkg_rules.RuleInvalidGraphQLSchema, Severity: models.SeverityError},
+		{FilePath: "b.graphqls", RuleID: pkg_rules.RuleInvalidGraphQLSchema, Severity: models.SeverityWarning},
+		{FilePath: "a.graphqls", RuleID: pkg_rules.RuleTypesHaveDescriptions, Severity: models.SeverityError},
+		{FilePath: "c.graphqls", RuleID: pkg_rules.RuleMissingQueryRootType, Severity: models.SeverityError},
 	}
+
+	assert.Equal(t, 1, fileConfigs{}.countInvalidSchemas(findings))
+
+	strict := fileConfigs{"b.graphqls": {Settings: models.Settings{StrictMode: true}}}
+	assert.Equal(t, 2, strict.countInvalidSchemas(findings))
 }
 
 func TestFindAndLogGraphQLSchemaFiles_Errors(t *testing.T) {
@@ -274,22 +229,3 @@ func TestFindAndLogGraphQLSchemaFiles_Errors(t *testing.T) {
 		t.Errorf("expected error for invalid path")
 	}
 }
-
-func TestLintSchemaFiles_Errors(t *testing.T) {
-	t.Parallel()
-
-	execute := Execute{Verbose: false}
-
-	total, errorFiles, _ := execute.lintSchemaFiles(nil, []string{"/does/not/exist.graphql"})
-	if total == 0 || errorFiles == 0 {
-		t.Errorf("expected errors for missing file")
-	}
-}
-
-func TestLogSchemaParseErrors_Errors(t *testing.T) {
-	t.Parallel()
-
-	_, report := astparser.ParseGraphqlDocumentString("type Query { id: ID } ...")
-	report.InternalErrors = append(report.InternalErrors, assert.AnError)
-	LogSchemaParseErrors("type Query { id: ID } ...", &report)
-}
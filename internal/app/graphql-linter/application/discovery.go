@@ -0,0 +1,195 @@
+package application
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+)
+
+const gitignoreFileName = ".gitignore"
+
+// defaultInclude selects the schema files that are linted when neither the
+// configuration nor the command line sets include patterns.
+var defaultInclude = []string{"**/*.graphql", "**/*.graphqls"}
+
+// Discovery selects the schema files in the target path. Include and Exclude
+// are path patterns that are matched against the path relative to the target
+// path, see pkg_rules.MatchPath. node_modules, vendor and dot-directories are
+// always skipped.
+type Discovery struct {
+	Include   []string
+	Exclude   []string
+	Gitignore bool
+}
+
+// withConfig combines the patterns from the command line with the ones from
+// the configuration. Include patterns from the command line replace the
+// configured ones, while exclude patterns are added to them.
+func (d Discovery) withConfig(config *models.LinterConfig) Discovery {
+	combined := Discovery{
+		Include:   d.Include,
+		Exclude:   append(append([]string{}, config.Exclude...), d.Exclude...),
+		Gitignore: d.Gitignore || config.Settings.RespectGitignore,
+	}
+
+	if len(combined.Include) == 0 {
+		combined.Include = config.Include
+	}
+
+	return combined
+}
+
+func (d Discovery) validate() error {
+	for _, pattern := range append(append([]string{}, d.Include...), d.Exclude...) {
+		err := pkg_rules.ValidatePathPattern(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid include or exclude pattern: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (d Discovery) included(relativePath string) bool {
+	include := d.Include
+	if len(include) == 0 {
+		include = defaultInclude
+	}
+
+	return matchesAny(include, relativePath) && !d.excluded(relativePath)
+}
+
+func (d Discovery) excluded(relativePath string) bool {
+	return matchesAny(d.Exclude, relativePath)
+}
+
+func matchesAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		if pkg_rules.MatchPath(pattern, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gitignore holds the rules of the .gitignore files that have been read. A
+// rule only applies to paths below the directory of its file, and a later
+// rule wins over an earlier one, so that the files have to be loaded from the
+// top of the tree down.
+type gitignore struct {
+	rules []gitignoreRule
+}
+
+type gitignoreRule struct {
+	base       string
+	expression *regexp.Regexp
+	negate     bool
+	dirOnly    bool
+}
+
+// load reads the .gitignore file in dir, if there is one.
+func (g *gitignore) load(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s directory: %w", gitignoreFileName, err)
+	}
+
+	file, err := os.Open(filepath.Join(dir, gitignoreFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", gitignoreFileName, err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		rule, ok := parseGitignoreLine(dir, scanner.Text())
+		if ok {
+			g.rules = append(g.rules, rule)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", gitignoreFileName, err)
+	}
+
+	return nil
+}
+
+// parseGitignoreLine supports comments, negation with "!", patterns that only
+// match directories with a trailing "/", and patterns that are anchored to the
+// directory of the .gitignore file because they contain a "/".
+func parseGitignoreLine(base, line string) (gitignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return gitignoreRule{}, false
+	}
+
+	rule := gitignoreRule{base: base}
+
+	if negated, found := strings.CutPrefix(line, "!"); found {
+		rule.negate = true
+		line = negated
+	}
+
+	line = strings.TrimPrefix(line, `\`)
+
+	if trimmed, found := strings.CutSuffix(line, "/"); found {
+		rule.dirOnly = true
+		line = trimmed
+	}
+
+	if anchored, found := strings.CutPrefix(line, "/"); found {
+		line = anchored
+	} else if !strings.Contains(line, "/") {
+		line = "**/" + line
+	}
+
+	expression, err := pkg_rules.CompileGlob(line)
+	if err != nil {
+		return gitignoreRule{}, false
+	}
+
+	rule.expression = expression
+
+	return rule, true
+}
+
+// ignored reports whether path is ignored by the rules that have been loaded.
+func (g *gitignore) ignored(path string, isDir bool) bool {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	ignored := false
+
+	for _, rule := range g.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+
+		relative, err := filepath.Rel(rule.base, path)
+		if err != nil || relative == "." || strings.HasPrefix(relative, "..") {
+			continue
+		}
+
+		if rule.expression.MatchString(filepath.ToSlash(relative)) {
+			ignored = !rule.negate
+		}
+	}
+
+	return ignored
+}
@@ -0,0 +1,73 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscovery_WithConfig(t *testing.T) {
+	t.Parallel()
+
+	config := &models.LinterConfig{
+		Include:  []string{"**/*.gql"},
+		Exclude:  []string{"**/__generated__/**"},
+		Settings: models.Settings{RespectGitignore: true},
+	}
+
+	assert.Equal(t, Discovery{
+		Include:   []string{"**/*.gql"},
+		Exclude:   []string{"**/__generated__/**", "fixtures/"},
+		Gitignore: true,
+	}, Discovery{Exclude: []string{"fixtures/"}}.withConfig(config))
+
+	assert.Equal(
+		t,
+		[]string{"schema/*.graphqls"},
+		Discovery{Include: []string{"schema/*.graphqls"}}.withConfig(config).Include,
+	)
+}
+
+func TestDiscovery_Included(t *testing.T) {
+	t.Parallel()
+
+	discovery := Discovery{Exclude: []string{"**/__generated__/**"}}
+
+	assert.True(t, discovery.included("schema/user.graphqls"))
+	assert.True(t, discovery.included("user.graphql"))
+	assert.False(t, discovery.included("schema/user.gql"))
+	assert.False(t, discovery.included("schema/__generated__/user.graphqls"))
+}
+
+func TestGitignore_Ignored(t *testing.T) {
+	t.Parallel()
+
+	ignore := &gitignore{}
+
+	for _, line := range []string{"# comment", "", "*.gql", "/generated/", "build/", "!keep.gql", "docs/*.graphql"} {
+		if rule, ok := parseGitignoreLine("/repo", line); ok {
+			ignore.rules = append(ignore.rules, rule)
+		}
+	}
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"/repo/schema/user.gql", false, true},
+		{"/repo/schema/keep.gql", false, false},
+		{"/repo/generated", true, true},
+		{"/repo/schema/generated", true, false},
+		{"/repo/schema/build", true, true},
+		{"/repo/build", false, false},
+		{"/repo/docs/user.graphql", false, true},
+		{"/repo/schema/docs/user.graphql", false, false},
+		{"/other/user.gql", false, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, ignore.ignored(test.path, test.isDir), test.path)
+	}
+}
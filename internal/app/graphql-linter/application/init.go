@@ -0,0 +1,85 @@
+package application
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	log "github.com/sirupsen/logrus"
+)
+
+const initSuppressionReason = "Existing finding when the linter was adopted"
+
+// Init writes a commented configuration file to path. With fromFindings, the
+// schema files in the target path are linted first and every finding is
+// suppressed, so that the build passes from the start and only new findings
+// fail it.
+func (e Execute) Init(path string, fromFindings bool) error {
+	var suppressions []models.Suppression
+
+	if fromFindings {
+		result, err := e.lint()
+		if err != nil {
+			return err
+		}
+
+		suppressions = suppressionsForFindings(result.findings, filepath.Dir(path))
+	}
+
+	err := data.WriteConfigTemplate(path, suppressions)
+	if err != nil {
+		return fmt.Errorf("unable to write config: %w", err)
+	}
+
+	log.Infof("wrote %s with %d suppression(s)", path, len(suppressions))
+
+	return nil
+}
+
+// suppressionsForFindings returns one suppression per file, rule and value of
+// the findings, with file paths relative to configDir. Findings about
+// suppressions themselves are skipped, as they go away with the new file.
+func suppressionsForFindings(findings []models.Finding, configDir string) []models.Suppression {
+	type suppressionKey struct {
+		file  string
+		rule  string
+		value string
+	}
+
+	seen := make(map[suppressionKey]bool, len(findings))
+	suppressions := make([]models.Suppression, 0, len(findings))
+
+	for _, finding := range findings {
+		rule, known := registry.Lookup(finding.RuleID)
+		if !known || rule.Category == pkg_rules.CategorySuppression {
+			continue
+		}
+
+		suppression := models.Suppression{
+			File:   data.RelativePath(configDir, finding.FilePath),
+			Rule:   models.RuleList{finding.RuleID},
+			Value:  pkg_rules.LiteralValue(pkg_rules.SuppressionValue(finding)),
+			Reason: initSuppressionReason,
+		}
+
+		key := suppressionKey{file: suppression.File, rule: finding.RuleID, value: suppression.Value}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		suppressions = append(suppressions, suppression)
+	}
+
+	slices.SortStableFunc(suppressions, func(a, b models.Suppression) int {
+		return strings.Compare(a.File+"\x00"+a.Rule[0], b.File+"\x00"+b.Rule[0])
+	})
+
+	return suppressions
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuppressionsForFindings(t *testing.T) {
+	t.Parallel()
+
+	findings := []models.Finding{
+		{FilePath: "schema/b.graphqls", RuleID: pkg_rules.RuleTypesHaveDescriptions, Coordinate: "Order"},
+		{FilePath: "schema/a.graphqls", RuleID: pkg_rules.RuleFieldsHaveDescriptions, Coordinate: "Query.users"},
+		{FilePath: "schema/a.graphqls", RuleID: pkg_rules.RuleFieldsHaveDescriptions, Coordinate: "Query.users"},
+		{FilePath: "schema/a.graphqls", RuleID: pkg_rules.RuleRelayPageInfoSpec},
+		{
+			FilePath:   "schema/a.graphqls",
+			RuleID:     pkg_rules.RuleSuspiciousEnumValue,
+			Coordinate: "Volume.PO4_VOLUME",
+		},
+		{FilePath: ".graphql-linter.yml", RuleID: pkg_rules.RuleUnusedSuppression, Coordinate: "suppressions[0]"},
+	}
+
+	got := suppressionsForFindings(findings, ".")
+
+	assert.Equal(t, []models.Suppression{
+		{
+			File:   "schema/a.graphqls",
+			Rule:   models.RuleList{pkg_rules.RuleFieldsHaveDescriptions},
+			Value:  "Query.users",
+			Reason: initSuppressionReason,
+		},
+		{
+			File:   "schema/a.graphqls",
+			Rule:   models.RuleList{pkg_rules.RuleRelayPageInfoSpec},
+			Reason: initSuppressionReason,
+		},
+		{
+			File:   "schema/a.graphqls",
+			Rule:   models.RuleList{pkg_rules.RuleSuspiciousEnumValue},
+			Value:  "PO4_VOLUME",
+			Reason: initSuppressionReason,
+		},
+		{
+			File:   "schema/b.graphqls",
+			Rule:   models.RuleList{pkg_rules.RuleTypesHaveDescriptions},
+			Value:  "Order",
+			Reason: initSuppressionReason,
+		},
+	}, got)
+}
+
+func TestExecute_Init(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), ".graphql-linter.yml")
+
+	require.NoError(t, Execute{}.Init(configPath, false))
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "suppressions: []")
+
+	assert.ErrorContains(t, Execute{}.Init(configPath, false), "already exists")
+}
@@ -0,0 +1,80 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data"
+)
+
+const (
+	// StdinPath is the path argument that reads a schema from stdin.
+	StdinPath = "-"
+
+	defaultStdinFilename = "<stdin>"
+)
+
+// Input holds the files and directories given on the command line. A path of
+// "-" reads a schema from Stdin, which is reported, matched by suppressions
+// and configured as if it were StdinFilename.
+type Input struct {
+	Paths         []string
+	Stdin         io.Reader
+	StdinFilename string
+}
+
+func (i Input) readsStdin() bool {
+	return slices.Contains(i.Paths, StdinPath)
+}
+
+func (i Input) stdinName() string {
+	if i.StdinFilename != "" {
+		return i.StdinFilename
+	}
+
+	return defaultStdinFilename
+}
+
+// targets returns the paths to lint: the -targetPath, if any, followed by the
+// positional paths, or the project root when there are none.
+func (e Execute) targets(projectRoot string) []string {
+	targets := slices.Clone(e.Input.Paths)
+	if e.TargetPath != "" {
+		targets = append([]string{e.TargetPath}, targets...)
+	}
+
+	if len(targets) == 0 {
+		targets = []string{projectRoot}
+	}
+
+	return targets
+}
+
+// readStdin reads the schema from stdin once, if one of the paths is "-".
+func (e Execute) readStdin() (string, error) {
+	if !e.Input.readsStdin() {
+		return "", nil
+	}
+
+	if e.Input.Stdin == nil {
+		return "", fmt.Errorf("unable to read %s: no input", e.Input.stdinName())
+	}
+
+	content, err := io.ReadAll(e.Input.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("unable to read %s: %w", e.Input.stdinName(), err)
+	}
+
+	return string(content), nil
+}
+
+// readSchema returns the content of a schema file, or of stdin for the stdin
+// file name.
+func (e Execute) readSchema(dataStore *data.Store, schemaFile string) (string, error) {
+	if e.Input.readsStdin() && schemaFile == e.Input.stdinName() {
+		return e.stdinSource, nil
+	}
+
+	return dataStore.ReadSchemaFile(schemaFile)
+}
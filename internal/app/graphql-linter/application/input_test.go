@@ -0,0 +1,86 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecute_Targets(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"/root"}, Execute{}.targets("/root"))
+	assert.Equal(t, []string{"schema"}, Execute{TargetPath: "schema"}.targets("/root"))
+	assert.Equal(
+		t,
+		[]string{"schema", "a.graphqls", "-"},
+		Execute{TargetPath: "schema", Input: Input{Paths: []string{"a.graphqls", "-"}}}.targets("/root"),
+	)
+}
+
+func TestFindAndLogGraphQLSchemaFiles_Paths(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDirectory(t, map[string]string{
+		"a.graphqls": "type Query { id: ID }",
+		"b.graphqls": "type Query { id: ID }",
+	})
+
+	execute := Execute{Input: Input{
+		Paths:         []string{filepath.Join(dir, "b.graphqls"), dir, StdinPath},
+		StdinFilename: "editor.graphqls",
+	}}
+
+	got, err := execute.FindAndLogGraphQLSchemaFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "b.graphqls"),
+		filepath.Join(dir, "a.graphqls"),
+		"editor.graphqls",
+	}, got)
+}
+
+func TestExecute_LintStdin(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), ".graphql-linter.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("settings:\n  validateFederation: false\n"), 0o600))
+
+	execute := Execute{
+		ConfigPath: configPath,
+		Input: Input{
+			Paths:         []string{StdinPath},
+			Stdin:         strings.NewReader("type Query {\n  id: ID\n}\n"),
+			StdinFilename: "schema/user.graphqls",
+		},
+	}
+
+	result, err := execute.lint()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"schema/user.graphqls"}, result.schemaFiles)
+	require.NotEmpty(t, result.findings)
+
+	rules := make([]string, 0, len(result.findings))
+
+	for _, finding := range result.findings {
+		assert.Equal(t, "schema/user.graphqls", finding.FilePath)
+
+		rules = append(rules, finding.RuleID)
+	}
+
+	assert.Contains(t, rules, pkg_rules.RuleTypesHaveDescriptions)
+}
+
+func TestExecute_ReadStdin_WithoutInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := Execute{Input: Input{Paths: []string{StdinPath}}}.readStdin()
+
+	assert.ErrorContains(t, err, "unable to read <stdin>")
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"slices"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/pkg/linter"
+)
+
+// publicConfigs converts the configurations that the config loader found to
+// the configurations of the linter package, once each, so that the linter
+// tracks the use of a suppression across every configuration that inherits it.
+type publicConfigs map[*models.LinterConfig]*linter.Config
+
+func (p publicConfigs) convert(config *models.LinterConfig) *linter.Config {
+	if converted, ok := p[config]; ok {
+		return converted
+	}
+
+	rules := make(map[string]linter.Level, len(config.Rules))
+	for id, level := range config.Rules {
+		rules[id] = linter.Level(level)
+	}
+
+	suppressions := make([]linter.Suppression, 0, len(config.DeclaredSuppressions()))
+	for _, suppression := range config.DeclaredSuppressions() {
+		suppressions = append(suppressions, linter.Suppression{
+			File:   suppression.File,
+			Line:   suppression.Line,
+			Rules:  slices.Clone(suppression.Rule),
+			Value:  suppression.Value,
+			Reason: suppression.Reason,
+			Owner:  suppression.Owner,
+			Until:  suppression.Until,
+		})
+	}
+
+	converted := &linter.Config{
+		Name: config.Path,
+		Settings: linter.Settings{
+			StrictMode:               config.Settings.StrictMode,
+			ValidateFederation:       config.Settings.ValidateFederation,
+			CheckDescriptions:        config.Settings.CheckDescriptions,
+			ReportUnusedSuppressions: config.Settings.ReportUnusedSuppressions,
+			RequireSuppressionReason: config.Settings.RequireSuppressionReason,
+		},
+		Rules:        rules,
+		Suppressions: suppressions,
+	}
+	p[config] = converted
+
+	if base := config.Base(); base != nil {
+		converted.Extends = p.convert(base)
+	}
+
+	return converted
+}
+
+// modelFindings converts the findings of the linter package to the findings
+// that the reports are written from.
+func modelFindings(findings []linter.Finding) []models.Finding {
+	converted := make([]models.Finding, 0, len(findings))
+
+	for _, finding := range findings {
+		converted = append(converted, models.Finding{
+			FilePath:    finding.File,
+			RuleID:      finding.Rule,
+			Severity:    models.Severity(finding.Severity),
+			Line:        finding.Line,
+			Column:      finding.Column,
+			EndLine:     finding.EndLine,
+			EndColumn:   finding.EndColumn,
+			Coordinate:  finding.Coordinate,
+			Message:     finding.Message,
+			Suggestion:  finding.Suggestion,
+			LineContent: finding.LineContent,
+		})
+	}
+
+	return converted
+}
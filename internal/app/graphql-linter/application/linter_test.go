@@ -0,0 +1,63 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/pkg/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicConfigs_Convert(t *testing.T) {
+	t.Parallel()
+
+	root, err := models.NewLinterConfig().Extend(".graphql-linter.yml", []byte(`settings:
+  strictMode: true
+rules:
+  types-have-descriptions: warn
+suppressions:
+  - file: a.graphql
+    rule: [fields-have-descriptions, types-have-descriptions]
+    reason: Legacy.
+`))
+	require.NoError(t, err)
+
+	nested, err := root.Extend("sub/.graphql-linter.yml", []byte(`suppressions:
+  - rule: fields-are-camel-cased
+`))
+	require.NoError(t, err)
+
+	configs := make(publicConfigs)
+	converted := configs.convert(nested)
+
+	assert.Same(t, configs.convert(root), converted.Extends)
+	assert.Equal(t, "sub/.graphql-linter.yml", converted.Name)
+	assert.Equal(t, []linter.Suppression{{Rules: []string{"fields-are-camel-cased"}}}, converted.Suppressions)
+	assert.Equal(t, map[string]linter.Level{"types-have-descriptions": linter.LevelWarn}, converted.Rules)
+	assert.Equal(t, []linter.Suppression{{
+		File:   "a.graphql",
+		Rules:  []string{"fields-have-descriptions", "types-have-descriptions"},
+		Reason: "Legacy.",
+	}}, converted.Extends.Suppressions)
+	assert.True(t, converted.Extends.Settings.StrictMode)
+	assert.True(t, converted.Extends.Settings.CheckDescriptions)
+}
+
+func TestModelFindings(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []models.Finding{{
+		FilePath: "a.graphql",
+		RuleID:   "fields-have-descriptions",
+		Severity: models.SeverityWarning,
+		Line:     2,
+		Message:  "Field 'Query.id' is missing a description",
+	}}, modelFindings([]linter.Finding{{
+		File:     "a.graphql",
+		Rule:     "fields-have-descriptions",
+		Severity: linter.SeverityWarning,
+		Line:     2,
+		Message:  "Field 'Query.id' is missing a description",
+	}}))
+}
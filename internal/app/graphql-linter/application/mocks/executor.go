@@ -37,7 +37,7 @@ func (_m *Executor) EXPECT() *Executor_Expecter {
 }
 
 // PrintReport provides a mock function for the type Executor
-func (_mock *Executor) PrintReport(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.DescriptionError) {
+func (_mock *Executor) PrintReport(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.Finding) {
 	_mock.Called(schemaFiles, totalErrors, passedFiles, allErrors)
 	return
 }
@@ -51,12 +51,12 @@ type Executor_PrintReport_Call struct {
 //   - schemaFiles []string
 //   - totalErrors int
 //   - passedFiles int
-//   - allErrors []models.DescriptionError
+//   - allErrors []models.Finding
 func (_e *Executor_Expecter) PrintReport(schemaFiles any, totalErrors any, passedFiles any, allErrors any) *Executor_PrintReport_Call {
 	return &Executor_PrintReport_Call{Call: _e.mock.On("PrintReport", schemaFiles, totalErrors, passedFiles, allErrors)}
 }
 
-func (_c *Executor_PrintReport_Call) Run(run func(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.DescriptionError)) *Executor_PrintReport_Call {
+func (_c *Executor_PrintReport_Call) Run(run func(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.Finding)) *Executor_PrintReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 []string
 		if args[0] != nil {
@@ -70,9 +70,9 @@ func (_c *Executor_PrintReport_Call) Run(run func(schemaFiles []string, totalErr
 		if args[2] != nil {
 			arg2 = args[2].(int)
 		}
-		var arg3 []models.DescriptionError
+		var arg3 []models.Finding
 		if args[3] != nil {
-			arg3 = args[3].([]models.DescriptionError)
+			arg3 = args[3].([]models.Finding)
 		}
 		run(
 			arg0,
@@ -89,7 +89,7 @@ func (_c *Executor_PrintReport_Call) Return() *Executor_PrintReport_Call {
 	return _c
 }
 
-func (_c *Executor_PrintReport_Call) RunAndReturn(run func(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.DescriptionError)) *Executor_PrintReport_Call {
+func (_c *Executor_PrintReport_Call) RunAndReturn(run func(schemaFiles []string, totalErrors int, passedFiles int, allErrors []models.Finding)) *Executor_PrintReport_Call {
 	_c.Run(run)
 	return _c
 }
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+)
+
+type Format string
+
+const (
+	FormatText       Format = "text"
+	FormatJSON       Format = "json"
+	FormatSARIF      Format = "sarif"
+	FormatJUnit      Format = "junit"
+	FormatCheckstyle Format = "checkstyle"
+	FormatGitHub     Format = "github"
+	FormatGitLab     Format = "gitlab"
+)
+
+func Formats() []Format {
+	return []Format{
+		FormatText,
+		FormatJSON,
+		FormatSARIF,
+		FormatJUnit,
+		FormatCheckstyle,
+		FormatGitHub,
+		FormatGitLab,
+	}
+}
+
+// FormatNames returns the supported formats as a comma separated list, for
+// use in flag descriptions and error messages.
+func FormatNames() string {
+	names := make([]string, 0, len(Formats()))
+	for _, format := range Formats() {
+		names = append(names, string(format))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func ParseFormat(value string) (Format, error) {
+	if value == "" {
+		return FormatText, nil
+	}
+
+	for _, format := range Formats() {
+		if strings.EqualFold(value, string(format)) {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported report format: '%s', expected one of: %s", value, FormatNames())
+}
+
+// Write renders the summary in the given format. On the terminal the text
+// format is printed through the logger by Print instead.
+func Write(writer io.Writer, format Format, summary Summary, toolVersion string) error {
+	switch format {
+	case FormatText:
+		return writeText(writer, summary)
+	case FormatJSON:
+		return writeJSON(writer, summary, toolVersion)
+	case FormatSARIF:
+		return writeSARIF(writer, summary, toolVersion)
+	case FormatJUnit:
+		return writeXML(writer, newJUnitReport(summary))
+	case FormatCheckstyle:
+		return writeXML(writer, newCheckstyleReport(summary))
+	case FormatGitHub:
+		return writeGitHub(writer, summary)
+	case FormatGitLab:
+		return writeGitLab(writer, summary)
+	default:
+		return fmt.Errorf("unsupported report format: '%s'", format)
+	}
+}
+
+// severity returns the severity of a finding, treating findings without one
+// as errors.
+func severity(err models.Finding) models.Severity {
+	if err.Severity == "" {
+		return models.SeverityError
+	}
+
+	return err.Severity
+}
@@ -0,0 +1,69 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	githubDataEscaper = strings.NewReplacer(
+		"%", "%25",
+		"\r", "%0D",
+		"\n", "%0A",
+	)
+	githubPropertyEscaper = strings.NewReplacer(
+		"%", "%25",
+		"\r", "%0D",
+		"\n", "%0A",
+		":", "%3A",
+		",", "%2C",
+	)
+)
+
+// writeGitHub prints a workflow command per finding, which GitHub Actions
+// turns into an annotation on the pull request diff.
+func writeGitHub(writer io.Writer, summary Summary) error {
+	for _, err := range summary.AllErrors {
+		var properties []string
+
+		if err.FilePath != "" {
+			path, _ := workingDirRelative(err.FilePath)
+			properties = append(properties, "file="+githubPropertyEscaper.Replace(path))
+		}
+
+		if err.Line > 0 {
+			properties = append(properties, fmt.Sprintf("line=%d", err.Line))
+		}
+
+		if err.Column > 0 {
+			properties = append(properties, fmt.Sprintf("col=%d", err.Column))
+		}
+
+		if err.RuleID != "" {
+			properties = append(properties, "title="+githubPropertyEscaper.Replace(err.RuleID))
+		}
+
+		message := err.Message
+		if err.Suggestion != "" {
+			message += "\n" + err.Suggestion
+		}
+
+		command := string(severity(err))
+		if len(properties) > 0 {
+			command += " " + strings.Join(properties, ",")
+		}
+
+		_, writeErr := fmt.Fprintf(
+			writer,
+			"::%s::%s\n",
+			command,
+			githubDataEscaper.Replace(message),
+		)
+		if writeErr != nil {
+			return fmt.Errorf("unable to write github annotation: %w", writeErr)
+		}
+	}
+
+	return nil
+}
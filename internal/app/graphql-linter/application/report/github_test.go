@@ -0,0 +1,82 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_GitHub(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		finding  models.Finding
+		expected string
+	}{
+		{
+			name: "annotation with line and rule",
+			finding: models.Finding{
+				FilePath: "schema/user.graphqls",
+				Line:     3,
+				RuleID:   "types-have-descriptions",
+				Message:  "Object type 'User' is missing a description",
+			},
+			expected: "::error file=schema/user.graphqls,line=3,title=types-have-descriptions::" +
+				"Object type 'User' is missing a description\n",
+		},
+		{
+			name: "escapes properties and message",
+			finding: models.Finding{
+				FilePath: "schema/a,b:c.graphqls",
+				RuleID:   "custom-rule",
+				Message:  "100% wrong\nsecond line",
+			},
+			expected: "::error file=schema/a%2Cb%3Ac.graphqls,title=custom-rule::100%25 wrong%0Asecond line\n",
+		},
+		{
+			name: "warning with column and suggestion",
+			finding: models.Finding{
+				FilePath:   "schema/user.graphqls",
+				Line:       2,
+				Column:     3,
+				RuleID:     "fields-are-camel-cased",
+				Severity:   models.SeverityWarning,
+				Message:    "The field 'User.user_name' is not camel cased.",
+				Suggestion: "Rename it to 'userName'.",
+			},
+			expected: "::warning file=schema/user.graphqls,line=2,col=3,title=fields-are-camel-cased::" +
+				"The field 'User.user_name' is not camel cased.%0ARename it to 'userName'.\n",
+		},
+		{
+			name: "schema-wide finding without a file",
+			finding: models.Finding{
+				RuleID:  "relay-page-info-spec",
+				Message: "A `PageInfo` object type is required as per the Relay spec.",
+			},
+			expected: "::error title=relay-page-info-spec::A `PageInfo` object type is required as per the Relay spec.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			summary := NewSummary(
+				[]string{tt.finding.FilePath},
+				1,
+				0,
+				[]models.Finding{tt.finding},
+			)
+
+			err := Write(&buf, FormatGitHub, summary, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+)
+
+const (
+	gitlabSeverityMajor = "major"
+	gitlabSeverityMinor = "minor"
+)
+
+// gitlabIssue is an entry of the GitLab Code Quality report, which is a subset
+// of the Code Climate issue format.
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+func newGitLabReport(summary Summary) []gitlabIssue {
+	fingerprints := newFingerprinter()
+	issues := make([]gitlabIssue, 0, len(summary.AllErrors))
+
+	for _, err := range summary.AllErrors {
+		path, _ := workingDirRelative(err.FilePath)
+
+		gitlabSeverity := gitlabSeverityMajor
+		if severity(err) == models.SeverityWarning {
+			gitlabSeverity = gitlabSeverityMinor
+		}
+
+		issues = append(issues, gitlabIssue{
+			Description: err.Message,
+			CheckName:   err.RuleID,
+			Fingerprint: fingerprints.next(err.RuleID, path, err.Message, err.LineContent),
+			Severity:    gitlabSeverity,
+			Location: gitlabLocation{
+				Path:  path,
+				Lines: gitlabLines{Begin: max(err.Line, 1)},
+			},
+		})
+	}
+
+	return issues
+}
+
+func writeGitLab(writer io.Writer, summary Summary) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(newGitLabReport(summary))
+	if err != nil {
+		return fmt.Errorf("unable to encode gitlab code quality report: %w", err)
+	}
+
+	return nil
+}
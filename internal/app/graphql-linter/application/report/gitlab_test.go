@@ -0,0 +1,59 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_GitLab(t *testing.T) {
+	t.Parallel()
+
+	finding := models.Finding{
+		FilePath:    "schema/user.graphqls",
+		Line:        4,
+		RuleID:      "fields-have-descriptions",
+		Message:     "Field 'User.id' is missing a description.",
+		LineContent: "id: ID!",
+	}
+
+	summary := NewSummary(
+		[]string{"schema/user.graphqls"},
+		2,
+		0,
+		[]models.Finding{finding, finding},
+	)
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatGitLab, summary, "")
+	require.NoError(t, err)
+
+	var got []gitlabIssue
+
+	err = json.Unmarshal(buf.Bytes(), &got)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, "fields-have-descriptions", got[0].CheckName)
+	assert.Equal(t, "Field 'User.id' is missing a description.", got[0].Description)
+	assert.Equal(t, "major", got[0].Severity)
+	assert.Equal(t, "schema/user.graphqls", got[0].Location.Path)
+	assert.Equal(t, 4, got[0].Location.Lines.Begin)
+	assert.NotEmpty(t, got[0].Fingerprint)
+	assert.NotEqual(t, got[0].Fingerprint, got[1].Fingerprint)
+}
+
+func TestWrite_GitLabNoFindings(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatGitLab, NewSummary([]string{"a.graphql"}, 0, 1, nil), "")
+	require.NoError(t, err)
+	assert.JSONEq(t, "[]", buf.String())
+}
@@ -0,0 +1,99 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONReportVersion is bumped whenever a field of the JSON report is renamed
+// or removed, so consumers can detect incompatible documents.
+const JSONReportVersion = "1"
+
+type jsonReport struct {
+	Version  string        `json:"version"`
+	Tool     jsonTool      `json:"tool"`
+	Findings []jsonFinding `json:"findings"`
+	Summary  jsonSummary   `json:"summary"`
+}
+
+type jsonTool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type jsonFinding struct {
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Column     int    `json:"column,omitempty"`
+	EndLine    int    `json:"endLine,omitempty"`
+	EndColumn  int    `json:"endColumn,omitempty"`
+	Rule       string `json:"rule"`
+	Severity   string `json:"severity"`
+	Coordinate string `json:"coordinate,omitempty"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+	Source     string `json:"source"`
+}
+
+type jsonSummary struct {
+	TotalFiles                int            `json:"totalFiles"`
+	PassedFiles               int            `json:"passedFiles"`
+	FilesWithAtLeastOneError  int            `json:"filesWithAtLeastOneError"`
+	TotalErrors               int            `json:"totalErrors"`
+	SchemaErrors              int            `json:"schemaErrors"`
+	PercentPassed             float64        `json:"percentPassed"`
+	PercentageFilesWithErrors float64        `json:"percentageFilesWithErrors"`
+	ErrorTypeCounts           map[string]int `json:"errorTypeCounts"`
+}
+
+func newJSONReport(summary Summary, toolVersion string) jsonReport {
+	findings := make([]jsonFinding, 0, len(summary.AllErrors))
+
+	for _, err := range summary.AllErrors {
+		findings = append(findings, jsonFinding{
+			File:       err.FilePath,
+			Line:       err.Line,
+			Column:     err.Column,
+			EndLine:    err.EndLine,
+			EndColumn:  err.EndColumn,
+			Rule:       err.RuleID,
+			Severity:   string(severity(err)),
+			Coordinate: err.Coordinate,
+			Message:    err.Message,
+			Suggestion: err.Suggestion,
+			Source:     err.LineContent,
+		})
+	}
+
+	return jsonReport{
+		Version: JSONReportVersion,
+		Tool: jsonTool{
+			Name:    toolName,
+			Version: toolVersion,
+		},
+		Findings: findings,
+		Summary: jsonSummary{
+			TotalFiles:                summary.TotalFiles,
+			PassedFiles:               summary.PassedFiles,
+			FilesWithAtLeastOneError:  summary.FilesWithAtLeastOneError,
+			TotalErrors:               summary.TotalErrors,
+			SchemaErrors:              summary.SchemaErrors,
+			PercentPassed:             summary.PercentPassed,
+			PercentageFilesWithErrors: summary.PercentageFilesWithErrors,
+			ErrorTypeCounts:           ErrorTypeCounts(summary.AllErrors),
+		},
+	}
+}
+
+func writeJSON(writer io.Writer, summary Summary, toolVersion string) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(newJSONReport(summary, toolVersion))
+	if err != nil {
+		return fmt.Errorf("unable to encode json report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_JSON(t *testing.T) {
+	t.Parallel()
+
+	summary := NewSummary(
+		[]string{"a.graphql", "b.graphql"},
+		1,
+		1,
+		[]models.Finding{
+			{
+				FilePath:    "a.graphql",
+				Line:        3,
+				Column:      6,
+				RuleID:      "types-have-descriptions",
+				Severity:    models.SeverityError,
+				Coordinate:  "User",
+				Message:     "Object type 'User' is missing a description",
+				LineContent: "type User {",
+			},
+		},
+	)
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatJSON, summary, "v1.2.3")
+	require.NoError(t, err)
+
+	var got jsonReport
+
+	err = json.Unmarshal(buf.Bytes(), &got)
+	require.NoError(t, err)
+
+	assert.Equal(t, JSONReportVersion, got.Version)
+	assert.Equal(t, jsonTool{Name: "graphql-linter", Version: "v1.2.3"}, got.Tool)
+	assert.Equal(t, []jsonFinding{
+		{
+			File:       "a.graphql",
+			Line:       3,
+			Column:     6,
+			Rule:       "types-have-descriptions",
+			Severity:   "error",
+			Coordinate: "User",
+			Message:    "Object type 'User' is missing a description",
+			Source:     "type User {",
+		},
+	}, got.Findings)
+	assert.Equal(t, 2, got.Summary.TotalFiles)
+	assert.Equal(t, 1, got.Summary.PassedFiles)
+	assert.Equal(t, 1, got.Summary.TotalErrors)
+	assert.Equal(t, map[string]int{"types-have-descriptions": 1}, got.Summary.ErrorTypeCounts)
+}
+
+func TestWrite_JSONNoFindings(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatJSON, NewSummary([]string{"a.graphql"}, 0, 1, nil), "")
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `"findings": []`)
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{"empty defaults to text", "", FormatText, false},
+		{"text", "text", FormatText, false},
+		{"json upper case", "JSON", FormatJSON, false},
+		{"unknown", "yaml", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFormat(test.value)
+			if test.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
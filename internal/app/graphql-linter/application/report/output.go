@@ -0,0 +1,82 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const outputFilePermissions = 0o644
+
+var (
+	errEmptyOutputPath     = errors.New("output path must not be empty")
+	errUnknownOutputFormat = errors.New("expected format=path with a format of: " + FormatNames())
+)
+
+// Output is a report that is written to a file in addition to the report that
+// is shown on the terminal.
+type Output struct {
+	Format Format
+	Path   string
+}
+
+// ParseOutput parses a "format=path" pair. A value without a format prefix is
+// treated as a path and is written in the default format.
+func ParseOutput(value string, defaultFormat Format) (Output, error) {
+	output := Output{Format: defaultFormat, Path: value}
+
+	if before, after, found := strings.Cut(value, "="); found && !strings.ContainsAny(before, `/\.`) {
+		format, err := ParseFormat(before)
+		if err != nil || before == "" {
+			return Output{}, fmt.Errorf("invalid output '%s': %w", value, errUnknownOutputFormat)
+		}
+
+		output = Output{Format: format, Path: after}
+	}
+
+	if output.Path == "" {
+		return Output{}, fmt.Errorf("invalid output '%s': %w", value, errEmptyOutputPath)
+	}
+
+	return output, nil
+}
+
+func (o Output) String() string {
+	return string(o.Format) + "=" + o.Path
+}
+
+// WriteFile renders the summary in the format of the output and writes it to
+// its path, creating the parent directories when needed.
+func WriteFile(output Output, summary Summary, toolVersion string) error {
+	dir := filepath.Dir(output.Path)
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("unable to create output directory '%s': %w", dir, err)
+	}
+
+	file, err := os.OpenFile(
+		filepath.Clean(output.Path),
+		os.O_CREATE|os.O_TRUNC|os.O_WRONLY,
+		outputFilePermissions,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create output file '%s': %w", output.Path, err)
+	}
+
+	err = Write(file, output.Format, summary, toolVersion)
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("unable to close output file '%s': %w", output.Path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		defaultFormat Format
+		expected      Output
+		expectErr     bool
+	}{
+		{
+			name:          "format and path",
+			value:         "sarif=lint.sarif",
+			defaultFormat: FormatText,
+			expected:      Output{Format: FormatSARIF, Path: "lint.sarif"},
+		},
+		{
+			name:          "path only uses default format",
+			value:         "reports/lint.json",
+			defaultFormat: FormatJSON,
+			expected:      Output{Format: FormatJSON, Path: "reports/lint.json"},
+		},
+		{
+			name:          "path containing equals sign",
+			value:         "reports/a=b.xml",
+			defaultFormat: FormatJUnit,
+			expected:      Output{Format: FormatJUnit, Path: "reports/a=b.xml"},
+		},
+		{
+			name:          "unknown format",
+			value:         "sarf=lint.sarif",
+			defaultFormat: FormatText,
+			expectErr:     true,
+		},
+		{
+			name:          "missing path",
+			value:         "junit=",
+			defaultFormat: FormatText,
+			expectErr:     true,
+		},
+		{
+			name:          "empty value",
+			value:         "",
+			defaultFormat: FormatText,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := ParseOutput(tt.value, tt.defaultFormat)
+			if tt.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output)
+		})
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	summary := NewSummary(
+		[]string{"a.graphql"},
+		1,
+		0,
+		[]models.Finding{
+			{
+				FilePath:    "a.graphql",
+				Line:        2,
+				RuleID:      "types-have-descriptions",
+				Message:     "Object type 'User' is missing a description",
+				LineContent: "type User {",
+			},
+		},
+	)
+
+	jsonPath := filepath.Join(dir, "nested", "lint.json")
+	require.NoError(t, WriteFile(Output{Format: FormatJSON, Path: jsonPath}, summary, ""))
+
+	content, err := os.ReadFile(jsonPath)
+	require.NoError(t, err)
+
+	var got jsonReport
+	require.NoError(t, json.Unmarshal(content, &got))
+	assert.Len(t, got.Findings, 1)
+
+	textPath := filepath.Join(dir, "lint.txt")
+	require.NoError(t, WriteFile(Output{Format: FormatText, Path: textPath}, summary, ""))
+
+	content, err = os.ReadFile(textPath)
+	require.NoError(t, err)
+
+	text := string(content)
+	assert.True(t, strings.HasPrefix(text, "a.graphql:2: types-have-descriptions: Object type 'User'"))
+	assert.Contains(t, text, "  types-have-descriptions: 1")
+	assert.Contains(t, text, "0 of 1 schema file(s) passed (0.00%)")
+	assert.Contains(t, text, "totalErrors: 1")
+}
@@ -3,15 +3,14 @@ package report
 import (
 	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 	log "github.com/sirupsen/logrus"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/operationreport"
 )
 
 const (
 	percentMultiplier = 100
+	toolName          = "graphql-linter"
 )
 
 type Summary struct {
@@ -21,37 +20,19 @@ type Summary struct {
 	PercentPassed             float64
 	PercentageFilesWithErrors float64
 	FilesWithAtLeastOneError  int
-	AllErrors                 []models.DescriptionError
-}
-
-func SummarizeLintResults(
-	unsuppressedDescErrs int,
-	hasUnsuppressedDeprecationReasonError bool,
-	unsuppressedDataTypeErrors int,
-	unsuppressedDirectiveOrFederationError bool,
-) (int, int) {
-	totalErrors := 0
-	errorFilesCount := 0
-
-	if unsuppressedDescErrs > 0 || hasUnsuppressedDeprecationReasonError ||
-		unsuppressedDataTypeErrors > 0 ||
-		unsuppressedDirectiveOrFederationError {
-		totalErrors += unsuppressedDescErrs + unsuppressedDataTypeErrors
-		if unsuppressedDirectiveOrFederationError {
-			totalErrors++
-		}
-
-		errorFilesCount++
-	}
-
-	return totalErrors, errorFilesCount
+	// SchemaErrors is the number of errors that belong to no file, such as the
+	// findings about a merged schema as a whole. They are part of TotalErrors,
+	// but do not count against PassedFiles.
+	SchemaErrors int
+	SchemaFiles  []string
+	AllErrors    []models.Finding
 }
 
 func NewSummary(
 	schemaFiles []string,
 	totalErrors int,
 	passedFiles int,
-	allErrors []models.DescriptionError,
+	allErrors []models.Finding,
 ) Summary {
 	totalFiles := len(schemaFiles)
 
@@ -77,19 +58,13 @@ func NewSummary(
 		TotalErrors:               totalErrors,
 		PercentPassed:             percentPassed,
 		PercentageFilesWithErrors: percentageFilesWithErrors,
+		SchemaFiles:               schemaFiles,
 		FilesWithAtLeastOneError:  filesWithAtLeastOneError,
 		AllErrors:                 allErrors,
 	}
 }
 
-func Print(
-	schemaFiles []string,
-	totalErrors int,
-	passedFiles int,
-	allErrors []models.DescriptionError,
-) {
-	summary := NewSummary(schemaFiles, totalErrors, passedFiles, allErrors)
-
+func Print(summary Summary) {
 	printDetailedErrors(summary.AllErrors)
 	printErrorTypeSummary(summary.AllErrors)
 
@@ -105,7 +80,11 @@ func Print(
 			"percentage":               fmt.Sprintf("%.2f%%", summary.PercentageFilesWithErrors),
 		}).Error("files with at least one error")
 
-		log.Fatalf("totalErrors: %d", summary.TotalErrors)
+		if summary.SchemaErrors > 0 {
+			log.Errorf("schemaErrors: %d", summary.SchemaErrors)
+		}
+
+		log.Errorf("totalErrors: %d", summary.TotalErrors)
 
 		return
 	}
@@ -113,17 +92,23 @@ func Print(
 	log.Infof("All %d schema file(s) passed linting successfully!", summary.TotalFiles)
 }
 
-func printDetailedErrors(errors []models.DescriptionError) {
+func printDetailedErrors(errors []models.Finding) {
 	if len(errors) == 0 {
 		return
 	}
 
 	for _, err := range errors {
-		log.Errorf("%s:%d: %s\n  %s", err.FilePath, err.LineNum, err.Message, err.LineContent)
+		if err.Severity == models.SeverityWarning {
+			log.Warn(findingText(err))
+
+			continue
+		}
+
+		log.Error(findingText(err))
 	}
 }
 
-func printErrorTypeSummary(errors []models.DescriptionError) {
+func printErrorTypeSummary(errors []models.Finding) {
 	errorTypeCountsMap := ErrorTypeCounts(errors)
 
 	if len(errorTypeCountsMap) == 0 {
@@ -144,84 +129,28 @@ func printErrorTypeSummary(errors []models.DescriptionError) {
 	}
 }
 
-func ErrorTypeCounts(errors []models.DescriptionError) map[string]int {
+// ErrorTypeCounts returns the number of findings per rule.
+func ErrorTypeCounts(errors []models.Finding) map[string]int {
 	counts := make(map[string]int)
 
 	for _, err := range errors {
-		msg := err.Message
-
-		typeKey := msg
-		if before, _, found := strings.Cut(msg, ":"); found {
-			typeKey = before
-		} else if before, _, found := strings.Cut(msg, " "); found {
-			typeKey = before
-		}
-
-		counts[typeKey]++
+		counts[err.RuleID]++
 	}
 
 	return counts
 }
 
-func InternalErrors(parseReport *operationreport.Report) {
-	for i, internalErr := range parseReport.InternalErrors {
-		log.Errorf("Internal Error %d: %v\n", i+1, internalErr)
+// findingText formats a finding as "file:line: rule: message" followed by the
+// offending source line and, if there is one, the suggested fix. Findings about
+// the schema as a whole have no file and are formatted as "rule: message".
+func findingText(err models.Finding) string {
+	text := fmt.Sprintf("%s:%d: %s\n  %s", err.FilePath, err.Line, err, err.LineContent)
+	if err.FilePath == "" {
+		text = err.String()
 	}
-}
-
-func ExternalErrors(
-	schemaString string,
-	parseReport *operationreport.Report,
-	linesBeforeContext, linesAfterContext int,
-) {
-	lines := strings.Split(schemaString, "\n")
-
-	for index, externalErr := range parseReport.ExternalErrors {
-		log.Errorf("External Error %d:\n", index+1)
-		log.Errorf("  Message: %s\n", externalErr.Message)
-		log.Errorf("  Path: %s\n", externalErr.Path)
-		reportExternalErrorLocations(lines, externalErr, linesBeforeContext, linesAfterContext)
-	}
-}
-
-func reportExternalErrorLocations(
-	lines []string,
-	externalErr operationreport.ExternalError,
-	linesBeforeContext, linesAfterContext int,
-) {
-	if externalErr.Locations == nil {
-		return
-	}
-
-	for _, location := range externalErr.Locations {
-		log.Infof("  Location: Line %d, Column %d\n", location.Line, location.Column)
-		reportContextLines(lines, int(location.Line), linesBeforeContext, linesAfterContext)
-	}
-}
-
-func reportContextLines(
-	lines []string,
-	lineNumber int,
-	linesBeforeContext, linesAfterContext int,
-) {
-	errorLineIdx := lineNumber - 1
-	if errorLineIdx < 0 || errorLineIdx >= len(lines) {
-		return
+	if err.Suggestion != "" {
+		text += "\n  " + err.Suggestion
 	}
 
-	log.Infof("  Problematic line: %s\n", lines[errorLineIdx])
-
-	startIdx := max(0, errorLineIdx-linesBeforeContext)
-	endIdx := min(len(lines), errorLineIdx+linesAfterContext+1)
-
-	log.Infof("  Context:")
-
-	for contextIdx := startIdx; contextIdx < endIdx; contextIdx++ {
-		marker := "  "
-		if contextIdx == errorLineIdx {
-			marker = ">>>"
-		}
-
-		log.Infof("  %s Line %d: %s\n", marker, contextIdx+1, lines[contextIdx])
-	}
+	return text
 }
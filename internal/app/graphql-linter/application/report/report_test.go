@@ -5,7 +5,6 @@ import (
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 	"github.com/stretchr/testify/assert"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/operationreport"
 )
 
 func TestStore_Summary(t *testing.T) {
@@ -16,7 +15,7 @@ func TestStore_Summary(t *testing.T) {
 		schemaFiles []string
 		totalErrors int
 		passedFiles int
-		allErrors   []models.DescriptionError
+		allErrors   []models.Finding
 		want        Summary
 	}{
 		{
@@ -40,8 +39,8 @@ func TestStore_Summary(t *testing.T) {
 			schemaFiles: []string{"a.graphql", "b.graphql"},
 			totalErrors: 1,
 			passedFiles: 1,
-			allErrors: []models.DescriptionError{
-				{FilePath: "a.graphql", LineNum: 1, Message: "error", LineContent: "foo"},
+			allErrors: []models.Finding{
+				{FilePath: "a.graphql", Line: 1, Message: "error", LineContent: "foo"},
 			},
 			want: Summary{
 				TotalFiles:                2,
@@ -50,8 +49,8 @@ func TestStore_Summary(t *testing.T) {
 				PercentPassed:             50.0,
 				PercentageFilesWithErrors: 50.0,
 				FilesWithAtLeastOneError:  1,
-				AllErrors: []models.DescriptionError{
-					{FilePath: "a.graphql", LineNum: 1, Message: "error", LineContent: "foo"},
+				AllErrors: []models.Finding{
+					{FilePath: "a.graphql", Line: 1, Message: "error", LineContent: "foo"},
 				},
 			},
 		},
@@ -126,32 +125,3 @@ func TestStore_Summary(t *testing.T) {
 		})
 	}
 }
-
-func TestReportInternalErrors_Empty(t *testing.T) {
-	t.Parallel()
-
-	report := &operationreport.Report{}
-	InternalErrors(report)
-}
-
-func TestReportExternalErrors_Empty(t *testing.T) {
-	t.Parallel()
-
-	report := &operationreport.Report{}
-	ExternalErrors("foo", report, 1, 1)
-}
-
-func TestReportExternalErrorLocations_Nil(t *testing.T) {
-	t.Parallel()
-
-	lines := []string{"foo"}
-	externalErr := operationreport.ExternalError{}
-	reportExternalErrorLocations(lines, externalErr, 1, 1)
-}
-
-func TestReportContextLines_OutOfBounds(t *testing.T) {
-	t.Parallel()
-
-	lines := []string{"foo"}
-	reportContextLines(lines, 100, 1, 1)
-}
@@ -0,0 +1,308 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+)
+
+const (
+	sarifFingerprintKey = "graphqlLinter/v1"
+	sarifFingerprintLen = 16
+	sarifLevelError     = "error"
+	sarifLevelWarning   = "warning"
+	sarifSchema         = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion        = "2.1.0"
+	toolInformationURI  = "https://github.com/schubergphilis/graphql-linter"
+	toolRulesHelpURI    = toolInformationURI + "#rules"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	Version        string                     `json:"version,omitempty"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name"`
+	ShortDescription     sarifMessage            `json:"shortDescription"`
+	FullDescription      sarifMessage            `json:"fullDescription"`
+	Help                 sarifMessage            `json:"help"`
+	HelpURI              string                  `json:"helpUri"`
+	DefaultConfiguration sarifRuleConfiguration  `json:"defaultConfiguration"`
+	Properties           sarifDescriptorProperty `json:"properties"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifDescriptorProperty struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string               `json:"ruleId"`
+	RuleIndex           int                  `json:"ruleIndex"`
+	Level               string               `json:"level"`
+	Message             sarifMessage         `json:"message"`
+	Locations           []sarifLocation      `json:"locations"`
+	PartialFingerprints map[string]string    `json:"partialFingerprints"`
+	Properties          *sarifResultProperty `json:"properties,omitempty"`
+}
+
+type sarifResultProperty struct {
+	Coordinate string `json:"coordinate,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	EndLine     int           `json:"endLine,omitempty"`
+	EndColumn   int           `json:"endColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+func newSARIFLog(summary Summary, toolVersion string) sarifLog {
+	descriptors, ruleIndexes := sarifRuleDescriptors(summary.AllErrors)
+	fingerprints := newFingerprinter()
+	results := make([]sarifResult, 0, len(summary.AllErrors))
+
+	for _, err := range summary.AllErrors {
+		uri := artifactURI(err.FilePath)
+
+		var location sarifLocation
+		if err.FilePath != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: uri},
+			}
+		}
+
+		if location.PhysicalLocation != nil && err.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   err.Line,
+				StartColumn: err.Column,
+				EndLine:     err.EndLine,
+				EndColumn:   err.EndColumn,
+			}
+			if err.LineContent != "" {
+				location.PhysicalLocation.Region.Snippet = &sarifMessage{Text: err.LineContent}
+			}
+		}
+
+		if err.Coordinate != "" {
+			location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: err.Coordinate}}
+		}
+
+		locations := []sarifLocation{}
+		if location.PhysicalLocation != nil || location.LogicalLocations != nil {
+			locations = append(locations, location)
+		}
+
+		result := sarifResult{
+			RuleID:    err.RuleID,
+			RuleIndex: ruleIndexes[err.RuleID],
+			Level:     sarifLevel(err),
+			Message:   sarifMessage{Text: err.Message},
+			Locations: locations,
+			PartialFingerprints: map[string]string{
+				sarifFingerprintKey: fingerprints.next(err.RuleID, uri, err.Message, err.LineContent),
+			},
+		}
+		if err.Coordinate != "" || err.Suggestion != "" {
+			result.Properties = &sarifResultProperty{
+				Coordinate: err.Coordinate,
+				Suggestion: err.Suggestion,
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           toolName,
+				Version:        toolVersion,
+				InformationURI: toolInformationURI,
+				Rules:          descriptors,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+// sarifRuleDescriptors lists every known rule, followed by any rule that only
+// shows up in the findings, and returns the index of each rule in that list.
+func sarifRuleDescriptors(
+	errors []models.Finding,
+) ([]sarifReportingDescriptor, map[string]int) {
+	rules := registry.Rules()
+	descriptors := make([]sarifReportingDescriptor, 0, len(rules))
+	ruleIndexes := make(map[string]int, len(rules))
+
+	for _, rule := range rules {
+		ruleIndexes[rule.ID] = len(descriptors)
+		descriptors = append(descriptors, newSARIFDescriptor(rule))
+	}
+
+	for _, err := range errors {
+		if _, ok := ruleIndexes[err.RuleID]; ok {
+			continue
+		}
+
+		ruleIndexes[err.RuleID] = len(descriptors)
+		descriptors = append(descriptors, newSARIFDescriptor(registry.Rule{
+			ID:              err.RuleID,
+			DefaultSeverity: models.SeverityError,
+			Description:     err.RuleID,
+		}))
+	}
+
+	return descriptors, ruleIndexes
+}
+
+func newSARIFDescriptor(rule registry.Rule) sarifReportingDescriptor {
+	var tags []string
+	if rule.Category != "" {
+		tags = []string{rule.Category}
+	}
+
+	level := sarifLevelError
+	if rule.DefaultSeverity == models.SeverityWarning {
+		level = sarifLevelWarning
+	}
+
+	return sarifReportingDescriptor{
+		ID:                   rule.ID,
+		Name:                 rule.ID,
+		ShortDescription:     sarifMessage{Text: rule.Description},
+		FullDescription:      sarifMessage{Text: rule.Description},
+		Help:                 sarifMessage{Text: rule.Description},
+		HelpURI:              toolRulesHelpURI,
+		DefaultConfiguration: sarifRuleConfiguration{Level: level},
+		Properties:           sarifDescriptorProperty{Tags: tags},
+	}
+}
+
+func sarifLevel(err models.Finding) string {
+	if severity(err) == models.SeverityWarning {
+		return sarifLevelWarning
+	}
+
+	return sarifLevelError
+}
+
+// fingerprinter derives fingerprints from the rule, file, message and source
+// line but not from the line number, so findings keep their identity when
+// code moves. Identical findings in one file get an occurrence suffix.
+type fingerprinter struct {
+	seen map[string]int
+}
+
+func newFingerprinter() fingerprinter {
+	return fingerprinter{seen: make(map[string]int)}
+}
+
+func (f fingerprinter) next(parts ...string) string {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		hash.Write([]byte(strings.TrimSpace(part)))
+		hash.Write([]byte{0})
+	}
+
+	digest := hex.EncodeToString(hash.Sum(nil))[:sarifFingerprintLen]
+	f.seen[digest]++
+
+	return fmt.Sprintf("%s:%d", digest, f.seen[digest])
+}
+
+func artifactURI(path string) string {
+	relativePath, ok := workingDirRelative(path)
+	if !ok {
+		return "file://" + relativePath
+	}
+
+	return relativePath
+}
+
+// workingDirRelative turns absolute paths below the working directory into
+// relative ones. It reports false for absolute paths outside of it.
+func workingDirRelative(path string) (string, bool) {
+	if filepath.IsAbs(path) {
+		workingDir, err := os.Getwd()
+		if err == nil {
+			relativePath, relErr := filepath.Rel(workingDir, path)
+			if relErr == nil && !strings.HasPrefix(relativePath, "..") {
+				return filepath.ToSlash(relativePath), true
+			}
+		}
+
+		return filepath.ToSlash(path), false
+	}
+
+	return filepath.ToSlash(path), true
+}
+
+func writeSARIF(writer io.Writer, summary Summary, toolVersion string) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(newSARIFLog(summary, toolVersion))
+	if err != nil {
+		return fmt.Errorf("unable to encode sarif report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_SARIF(t *testing.T) {
+	t.Parallel()
+
+	summary := NewSummary(
+		[]string{"schema/user.graphqls"},
+		2,
+		0,
+		[]models.Finding{
+			{
+				FilePath:    "schema/user.graphqls",
+				Line:        3,
+				RuleID:      "types-have-descriptions",
+				Message:     "Object type 'User' is missing a description",
+				LineContent: "type User {",
+			},
+			{
+				FilePath: "schema/user.graphqls",
+				Line:     0,
+				RuleID:   "custom-rule",
+				Message:  "something else",
+			},
+			{
+				RuleID:     "relay-page-info-spec",
+				Coordinate: "PageInfo",
+				Message:    "A `PageInfo` object type is required as per the Relay spec.",
+			},
+		},
+	)
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatSARIF, summary, "v1.2.3")
+	require.NoError(t, err)
+
+	var got sarifLog
+
+	err = json.Unmarshal(buf.Bytes(), &got)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.1.0", got.Version)
+	require.Len(t, got.Runs, 1)
+
+	run := got.Runs[0]
+	assert.Equal(t, "graphql-linter", run.Tool.Driver.Name)
+	assert.Equal(t, "v1.2.3", run.Tool.Driver.Version)
+	require.Len(t, run.Results, 3)
+
+	first := run.Results[0]
+	assert.Equal(t, "types-have-descriptions", first.RuleID)
+	assert.Equal(t, "types-have-descriptions", run.Tool.Driver.Rules[first.RuleIndex].ID)
+	assert.Equal(t, "Object types must have a description.", run.Tool.Driver.Rules[first.RuleIndex].Help.Text)
+	assert.Equal(t, "schema/user.graphqls", first.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.NotNil(t, first.Locations[0].PhysicalLocation.Region)
+	assert.Equal(t, 3, first.Locations[0].PhysicalLocation.Region.StartLine)
+
+	second := run.Results[1]
+	assert.Equal(t, "custom-rule", run.Tool.Driver.Rules[second.RuleIndex].ID)
+	assert.Nil(t, second.Locations[0].PhysicalLocation.Region)
+
+	schemaWide := run.Results[2]
+	require.Len(t, schemaWide.Locations, 1)
+	assert.Nil(t, schemaWide.Locations[0].PhysicalLocation)
+	assert.Equal(t, "PageInfo", schemaWide.Locations[0].LogicalLocations[0].FullyQualifiedName)
+}
+
+func TestFingerprinter_IgnoresLineNumbers(t *testing.T) {
+	t.Parallel()
+
+	errorAt := func(line int) models.Finding {
+		return models.Finding{
+			FilePath:    "a.graphql",
+			Line:        line,
+			RuleID:      "fields-have-descriptions",
+			Message:     "Field 'User.id' is missing a description.",
+			LineContent: "id: ID!",
+		}
+	}
+
+	before := newSARIFLog(NewSummary([]string{"a.graphql"}, 1, 0, []models.Finding{errorAt(4)}), "")
+	after := newSARIFLog(NewSummary([]string{"a.graphql"}, 1, 0, []models.Finding{errorAt(9)}), "")
+
+	assert.Contains(t, before.Runs[0].Results[0].PartialFingerprints, "graphqlLinter/v1")
+	assert.Equal(
+		t,
+		before.Runs[0].Results[0].PartialFingerprints,
+		after.Runs[0].Results[0].PartialFingerprints,
+	)
+}
+
+func TestFingerprinter_DistinguishesOccurrences(t *testing.T) {
+	t.Parallel()
+
+	fingerprints := newFingerprinter()
+
+	first := fingerprints.next("rule", "a.graphql", "message")
+	second := fingerprints.next("rule", "a.graphql", "message")
+
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, first[:len(first)-1], second[:len(second)-1])
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// writeText renders the same information as Print as plain text, so the
+// human-readable report can be stored as an artifact.
+func writeText(writer io.Writer, summary Summary) error {
+	var lines []string
+
+	for _, err := range summary.AllErrors {
+		lines = append(lines, findingText(err))
+	}
+
+	counts := ErrorTypeCounts(summary.AllErrors)
+	if len(counts) > 0 {
+		keys := make([]string, 0, len(counts))
+		for key := range counts {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		lines = append(lines, "", "Error type summary:")
+		for _, key := range keys {
+			lines = append(lines, fmt.Sprintf("  %s: %d", key, counts[key]))
+		}
+
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, fmt.Sprintf(
+		"%d of %d schema file(s) passed (%.2f%%)",
+		summary.PassedFiles,
+		summary.TotalFiles,
+		summary.PercentPassed,
+	))
+
+	if summary.TotalErrors > 0 {
+		lines = append(lines, fmt.Sprintf(
+			"%d file(s) with at least one error (%.2f%%), totalErrors: %d",
+			summary.FilesWithAtLeastOneError,
+			summary.PercentageFilesWithErrors,
+			summary.TotalErrors,
+		))
+	}
+
+	if summary.SchemaErrors > 0 {
+		lines = append(lines, fmt.Sprintf("%d error(s) about the schema as a whole", summary.SchemaErrors))
+	}
+
+	for _, line := range lines {
+		_, err := fmt.Fprintln(writer, line)
+		if err != nil {
+			return fmt.Errorf("unable to write text report: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+)
+
+const (
+	checkstyleVersion   = "4.3"
+	junitPassedTestName = "graphql-linter"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// errorsPerFile groups the findings by schema file. Every schema file gets an
+// entry, including the ones without findings, in the order they were linted.
+func errorsPerFile(summary Summary) ([]string, map[string][]models.Finding) {
+	files := make([]string, 0, len(summary.SchemaFiles))
+	grouped := make(map[string][]models.Finding, len(summary.SchemaFiles))
+
+	for _, file := range summary.SchemaFiles {
+		if _, ok := grouped[file]; ok {
+			continue
+		}
+
+		files = append(files, file)
+		grouped[file] = nil
+	}
+
+	for _, err := range summary.AllErrors {
+		if _, ok := grouped[err.FilePath]; !ok {
+			files = append(files, err.FilePath)
+		}
+
+		grouped[err.FilePath] = append(grouped[err.FilePath], err)
+	}
+
+	return files, grouped
+}
+
+func newJUnitReport(summary Summary) junitTestSuites {
+	files, grouped := errorsPerFile(summary)
+	suites := junitTestSuites{
+		Name:   toolName,
+		Suites: make([]junitTestSuite, 0, len(files)),
+	}
+
+	for _, file := range files {
+		suite := junitTestSuite{Name: file}
+
+		for _, err := range grouped[file] {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      err.RuleID + ":" + strconv.Itoa(err.Line),
+				ClassName: file,
+				Failure: &junitFailure{
+					Message: err.Message,
+					Type:    err.RuleID,
+					Text:    findingText(err),
+				},
+			})
+		}
+
+		suite.Failures = len(suite.TestCases)
+		if suite.Failures == 0 {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      junitPassedTestName,
+				ClassName: file,
+			})
+		}
+
+		suite.Tests = len(suite.TestCases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	return suites
+}
+
+func newCheckstyleReport(summary Summary) checkstyleReport {
+	files, grouped := errorsPerFile(summary)
+	checkstyle := checkstyleReport{
+		Version: checkstyleVersion,
+		Files:   make([]checkstyleFile, 0, len(files)),
+	}
+
+	for _, file := range files {
+		checkstyleFile := checkstyleFile{Name: file}
+
+		for _, err := range grouped[file] {
+			checkstyleFile.Errors = append(checkstyleFile.Errors, checkstyleError{
+				Line:     err.Line,
+				Column:   err.Column,
+				Severity: string(severity(err)),
+				Message:  err.Message,
+				Source:   toolName + "." + err.RuleID,
+			})
+		}
+
+		checkstyle.Files = append(checkstyle.Files, checkstyleFile)
+	}
+
+	return checkstyle
+}
+
+func writeXML(writer io.Writer, document any) error {
+	_, err := io.WriteString(writer, xml.Header)
+	if err != nil {
+		return fmt.Errorf("unable to write xml header: %w", err)
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(document)
+	if err != nil {
+		return fmt.Errorf("unable to encode xml report: %w", err)
+	}
+
+	_, err = io.WriteString(writer, "\n")
+	if err != nil {
+		return fmt.Errorf("unable to write xml report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func xmlTestSummary() Summary {
+	return NewSummary(
+		[]string{"schema/clean.graphqls", "schema/user.graphqls"},
+		2,
+		1,
+		[]models.Finding{
+			{
+				FilePath:    "schema/user.graphqls",
+				Line:        3,
+				RuleID:      "types-have-descriptions",
+				Message:     "Object type 'User' is missing a description",
+				LineContent: "type User {",
+			},
+			{
+				FilePath:    "schema/user.graphqls",
+				Line:        4,
+				RuleID:      "fields-have-descriptions",
+				Message:     "Field 'User.id' is missing a description.",
+				LineContent: "id: ID!",
+			},
+		},
+	)
+}
+
+func TestWrite_JUnit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatJUnit, xmlTestSummary(), "")
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(xml.Header)))
+
+	var got junitTestSuites
+
+	err = xml.Unmarshal(buf.Bytes(), &got)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, got.Tests)
+	assert.Equal(t, 2, got.Failures)
+	require.Len(t, got.Suites, 2)
+
+	clean := got.Suites[0]
+	assert.Equal(t, "schema/clean.graphqls", clean.Name)
+	assert.Equal(t, 1, clean.Tests)
+	assert.Equal(t, 0, clean.Failures)
+	require.Len(t, clean.TestCases, 1)
+	assert.Nil(t, clean.TestCases[0].Failure)
+
+	user := got.Suites[1]
+	assert.Equal(t, "schema/user.graphqls", user.Name)
+	assert.Equal(t, 2, user.Failures)
+	require.Len(t, user.TestCases, 2)
+	assert.Equal(t, "types-have-descriptions:3", user.TestCases[0].Name)
+	require.NotNil(t, user.TestCases[0].Failure)
+	assert.Equal(t, "types-have-descriptions", user.TestCases[0].Failure.Type)
+	assert.Equal(t, "Object type 'User' is missing a description", user.TestCases[0].Failure.Message)
+}
+
+func TestWrite_Checkstyle(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := Write(&buf, FormatCheckstyle, xmlTestSummary(), "")
+	require.NoError(t, err)
+
+	var got checkstyleReport
+
+	err = xml.Unmarshal(buf.Bytes(), &got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Files, 2)
+	assert.Equal(t, "schema/clean.graphqls", got.Files[0].Name)
+	assert.Empty(t, got.Files[0].Errors)
+
+	require.Len(t, got.Files[1].Errors, 2)
+	assert.Equal(t, checkstyleError{
+		Line:     4,
+		Severity: "error",
+		Message:  "Field 'User.id' is missing a description.",
+		Source:   "graphql-linter.fields-have-descriptions",
+	}, got.Files[1].Errors[1])
+}
@@ -0,0 +1,170 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+)
+
+// The formats in which the rules can be listed.
+const (
+	RulesFormatTable = "table"
+	RulesFormatJSON  = "json"
+)
+
+const tabwriterPadding = 2
+
+var errUnknownRule = errors.New("unknown rule")
+
+type jsonRule struct {
+	ID              string   `json:"id"`
+	Category        string   `json:"category"`
+	DefaultSeverity string   `json:"defaultSeverity"`
+	Fixable         bool     `json:"fixable"`
+	Description     string   `json:"description"`
+	Options         []string `json:"options,omitempty"`
+}
+
+// ListRules writes every rule with its category, default severity and whether
+// it is fixable, as a table or as JSON.
+func ListRules(writer io.Writer, format string) error {
+	switch format {
+	case RulesFormatTable:
+		return writeRulesTable(writer)
+	case RulesFormatJSON:
+		return writeRulesJSON(writer)
+	default:
+		return fmt.Errorf(
+			"unsupported rules format: '%s', expected one of: %s, %s",
+			format,
+			RulesFormatTable,
+			RulesFormatJSON,
+		)
+	}
+}
+
+func writeRulesTable(writer io.Writer) error {
+	table := tabwriter.NewWriter(writer, 0, 0, tabwriterPadding, ' ', 0)
+
+	_, _ = fmt.Fprintln(table, "RULE\tCATEGORY\tSEVERITY\tFIXABLE\tDESCRIPTION")
+
+	for _, rule := range registry.Rules() {
+		_, _ = fmt.Fprintf(
+			table,
+			"%s\t%s\t%s\t%s\t%s\n",
+			rule.ID,
+			rule.Category,
+			rule.DefaultSeverity,
+			yesNo(rule.Fixable),
+			rule.Description,
+		)
+	}
+
+	err := table.Flush()
+	if err != nil {
+		return fmt.Errorf("unable to write rules: %w", err)
+	}
+
+	return nil
+}
+
+func writeRulesJSON(writer io.Writer) error {
+	rules := make([]jsonRule, 0, len(registry.Rules()))
+
+	for _, rule := range registry.Rules() {
+		var options []string
+		for _, option := range rule.Options {
+			options = append(options, option.Setting)
+		}
+
+		rules = append(rules, jsonRule{
+			ID:              rule.ID,
+			Category:        rule.Category,
+			DefaultSeverity: string(rule.DefaultSeverity),
+			Fixable:         rule.Fixable,
+			Description:     rule.Description,
+			Options:         options,
+		})
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(rules)
+	if err != nil {
+		return fmt.Errorf("unable to encode rules: %w", err)
+	}
+
+	return nil
+}
+
+// ExplainRule writes the documentation of a rule: what it checks, why, the
+// settings it depends on and examples of a schema it reports and of the same
+// schema once the finding has been fixed.
+func ExplainRule(writer io.Writer, id string) error {
+	rule, ok := registry.Lookup(id)
+	if !ok {
+		ids := make([]string, 0, len(registry.Rules()))
+		for _, known := range registry.Rules() {
+			ids = append(ids, known.ID)
+		}
+
+		if closest := pkg_rules.ClosestMatch(id, ids); closest != "" {
+			return fmt.Errorf("%w: '%s', did you mean '%s'?", errUnknownRule, id, closest)
+		}
+
+		return fmt.Errorf("%w: '%s', run the rules command to list them", errUnknownRule, id)
+	}
+
+	var text strings.Builder
+
+	fmt.Fprintf(&text, "%s\n\n%s\n\n", rule.ID, rule.Description)
+	fmt.Fprintf(&text, "Category:         %s\n", rule.Category)
+	fmt.Fprintf(&text, "Default severity: %s\n", rule.DefaultSeverity)
+	fmt.Fprintf(&text, "Fixable:          %s\n", yesNo(rule.Fixable))
+
+	for _, option := range rule.Options {
+		fmt.Fprintf(&text, "Setting:          %s (%s)\n", option.Setting, option.Description)
+	}
+
+	fmt.Fprintf(&text, "\n%s\n", rule.Rationale)
+
+	for _, example := range rule.Examples {
+		fmt.Fprintf(&text, "\nInvalid:\n\n%s\n", indent(example.Invalid))
+		fmt.Fprintf(&text, "\nValid:\n\n%s\n", indent(example.Valid))
+	}
+
+	_, err := io.WriteString(writer, text.String())
+	if err != nil {
+		return fmt.Errorf("unable to write rule: %w", err)
+	}
+
+	return nil
+}
+
+// indent indents the non-empty lines of an example, so it stands out from the
+// text around it.
+func indent(schema string) string {
+	lines := strings.Split(schema, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "    " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,90 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRules_Table(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	require.NoError(t, ListRules(&out, RulesFormatTable))
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, len(registry.Rules())+1)
+	assert.Regexp(t, `^RULE\s+CATEGORY\s+SEVERITY\s+FIXABLE\s+DESCRIPTION$`, string(lines[0]))
+	assert.Regexp(t, `^arguments-have-descriptions\s+schema\s+error\s+no\s+Field arguments`, string(lines[1]))
+}
+
+func TestListRules_JSON(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	require.NoError(t, ListRules(&out, RulesFormatJSON))
+
+	var rules []jsonRule
+	require.NoError(t, json.Unmarshal(out.Bytes(), &rules))
+	require.Len(t, rules, len(registry.Rules()))
+	assert.Equal(t, jsonRule{
+		ID:              "arguments-have-descriptions",
+		Category:        "schema",
+		DefaultSeverity: "error",
+		Description:     "Field arguments must have a description.",
+		Options:         []string{"checkDescriptions"},
+	}, rules[0])
+}
+
+func TestListRules_UnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	err := ListRules(&bytes.Buffer{}, "yaml")
+	require.EqualError(t, err, "unsupported rules format: 'yaml', expected one of: table, json")
+}
+
+func TestExplainRule(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	require.NoError(t, ExplainRule(&out, "fields-are-camel-cased"))
+
+	text := out.String()
+	assert.Contains(t, text, "fields-are-camel-cased\n\nObject type field names must be camelCased.\n")
+	assert.Contains(t, text, "Default severity: error\n")
+	assert.Contains(t, text, "camelCase is the naming convention")
+	assert.Contains(t, text, "Invalid:\n\n    type User {\n      first_name: String\n    }\n")
+	assert.Contains(t, text, "Valid:\n\n    type User {\n      firstName: String\n    }\n")
+}
+
+func TestExplainRule_UnknownRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{"typo", "field-are-camel-cased", "unknown rule: 'field-are-camel-cased', did you mean 'fields-are-camel-cased'?"},
+		{"unrelated", "no-such-rule", "unknown rule: 'no-such-rule', run the rules command to list them"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+
+			err := ExplainRule(&out, test.id)
+			require.ErrorIs(t, err, errUnknownRule)
+			require.EqualError(t, err, test.want)
+			assert.Empty(t, out.String())
+		})
+	}
+}
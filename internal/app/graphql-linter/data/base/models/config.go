@@ -1,20 +1,216 @@
 package models
 
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Suppression silences the findings it matches. File is a path suffix or a
+// glob pattern, and Value an exact symbol, a glob pattern or, when anchored
+// with ^ or $, a regular expression.
 type Suppression struct {
-	File   string `yaml:"file"`
-	Line   int    `yaml:"line"`
-	Rule   string `yaml:"rule"`
-	Value  string `yaml:"value"`
-	Reason string `yaml:"reason"`
+	File   string   `yaml:"file,omitempty"`
+	Line   int      `yaml:"line,omitempty"`
+	Rule   RuleList `yaml:"rule,omitempty"`
+	Value  string   `yaml:"value,omitempty"`
+	Reason string   `yaml:"reason,omitempty"`
+	// Until is the last day on which the suppression applies. The zero value
+	// means that it never expires.
+	Until time.Time `yaml:"until,omitempty"`
+	Owner string    `yaml:"owner,omitempty"`
+
+	origin *suppressionOrigin
+}
+
+// suppressionOrigin records whether a suppression has matched a finding. The
+// configurations that inherit the suppression through extends share it, so
+// that a suppression that is used by one of them is not reported as unused.
+type suppressionOrigin struct {
+	used bool
+}
+
+// Used reports whether the suppression has matched a finding.
+func (s Suppression) Used() bool {
+	return s.origin != nil && s.origin.used
+}
+
+// Expired reports whether the Until date of the suppression lies before the
+// day of now.
+func (s Suppression) Expired(now time.Time) bool {
+	if s.Until.IsZero() {
+		return false
+	}
+
+	year, month, day := now.Date()
+
+	return s.Until.Before(time.Date(year, month, day, 0, 0, 0, 0, s.Until.Location()))
+}
+
+// RuleList holds the rule identifiers of a suppression. In YAML it is either a
+// single rule identifier or a list of them.
+type RuleList []string
+
+func (r *RuleList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var ruleID string
+
+		err := value.Decode(&ruleID)
+		if err != nil {
+			return fmt.Errorf("failed to decode rule: %w", err)
+		}
+
+		*r = RuleList{ruleID}
+		if ruleID == "" {
+			*r = nil
+		}
+
+		return nil
+	default:
+		var ruleIDs []string
+
+		err := value.Decode(&ruleIDs)
+		if err != nil {
+			return fmt.Errorf("failed to decode rules: %w", err)
+		}
+
+		*r = ruleIDs
+
+		return nil
+	}
+}
+
+// MarshalYAML writes a single rule identifier as a scalar, so that the common
+// case keeps its familiar form.
+func (r RuleList) MarshalYAML() (any, error) {
+	if len(r) == 1 {
+		return r[0], nil
+	}
+
+	return []string(r), nil
 }
 
 type Settings struct {
-	StrictMode         bool `yaml:"strictMode"`
-	ValidateFederation bool `yaml:"validateFederation"`
-	CheckDescriptions  bool `yaml:"checkDescriptions"`
+	StrictMode               bool `yaml:"strictMode"`
+	ValidateFederation       bool `yaml:"validateFederation"`
+	CheckDescriptions        bool `yaml:"checkDescriptions"`
+	ReportUnusedSuppressions bool `yaml:"reportUnusedSuppressions"`
+	RequireSuppressionReason bool `yaml:"requireSuppressionReason"`
+	RespectGitignore         bool `yaml:"respectGitignore"`
 }
 
+// RuleLevel configures whether a rule runs and, if it does, the severity of
+// its findings.
+type RuleLevel string
+
+const (
+	RuleLevelOff   RuleLevel = "off"
+	RuleLevelWarn  RuleLevel = "warn"
+	RuleLevelError RuleLevel = "error"
+)
+
 type LinterConfig struct {
-	Suppressions []Suppression `yaml:"suppressions"`
-	Settings     Settings      `yaml:"settings"`
+	// Extends is the path, relative to this file, of a configuration whose
+	// settings, rules and suppressions are inherited.
+	Extends string `yaml:"extends"`
+	// Include and Exclude are path patterns that select the schema files to
+	// lint. Include replaces the default extensions, Exclude adds to the
+	// directories that are always skipped.
+	Include      []string             `yaml:"include"`
+	Exclude      []string             `yaml:"exclude"`
+	Suppressions []Suppression        `yaml:"suppressions"`
+	Settings     Settings             `yaml:"settings"`
+	Rules        map[string]RuleLevel `yaml:"rules"`
+	// Path is the file the configuration was loaded from, if any.
+	Path string `yaml:"-"`
+
+	// base is the configuration that this one extends, and inherited is the
+	// number of leading suppressions that come from it.
+	base      *LinterConfig
+	inherited int
+}
+
+// NewLinterConfig returns the configuration that applies when a configuration
+// file does not override it.
+func NewLinterConfig() *LinterConfig {
+	return &LinterConfig{
+		Settings: Settings{
+			StrictMode:         false,
+			ValidateFederation: true,
+			CheckDescriptions:  true,
+		},
+	}
+}
+
+// Extend returns the configuration that the YAML document in data, loaded from
+// path, describes when it extends c. Its settings and rules override those of
+// c, and its suppressions are added to the ones of c.
+func (c *LinterConfig) Extend(path string, data []byte) (*LinterConfig, error) {
+	extended := &LinterConfig{
+		Include:  c.Include,
+		Exclude:  c.Exclude,
+		Settings: c.Settings,
+		Rules:    maps.Clone(c.Rules),
+	}
+
+	err := yaml.Unmarshal(data, extended)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	extended.Path = path
+
+	return c.Inherit(extended), nil
+}
+
+// Inherit makes extended a configuration that extends c and returns it. The
+// suppressions of c are added in front of its own, and they count as used for
+// c as well when they match a finding of extended.
+func (c *LinterConfig) Inherit(extended *LinterConfig) *LinterConfig {
+	for index := range c.Suppressions {
+		c.suppressionOrigin(index)
+	}
+
+	extended.base = c
+	extended.inherited = len(c.Suppressions)
+	extended.Suppressions = append(slices.Clone(c.Suppressions), extended.Suppressions...)
+
+	return extended
+}
+
+// Base returns the configuration that c extends, or nil if it does not extend
+// one.
+func (c *LinterConfig) Base() *LinterConfig {
+	return c.base
+}
+
+// Fails reports whether a finding fails the run under the configuration: a
+// finding with error severity always does, a warning only in strict mode.
+func (c *LinterConfig) Fails(finding Finding) bool {
+	return finding.Severity != SeverityWarning || (c != nil && c.Settings.StrictMode)
+}
+
+// DeclaredSuppressions returns the suppressions that are declared in the
+// configuration itself rather than inherited through extends, in the order of
+// its suppressions section.
+func (c *LinterConfig) DeclaredSuppressions() []Suppression {
+	return c.Suppressions[c.inherited:]
+}
+
+// MarkSuppressionUsed records that the suppression at the given index matched
+// a finding.
+func (c *LinterConfig) MarkSuppressionUsed(index int) {
+	c.suppressionOrigin(index).used = true
+}
+
+func (c *LinterConfig) suppressionOrigin(index int) *suppressionOrigin {
+	if c.Suppressions[index].origin == nil {
+		c.Suppressions[index].origin = &suppressionOrigin{}
+	}
+
+	return c.Suppressions[index].origin
 }
@@ -1,8 +0,0 @@
-package models
-
-type DescriptionError struct {
-	FilePath    string
-	LineNum     int
-	Message     string
-	LineContent string
-}
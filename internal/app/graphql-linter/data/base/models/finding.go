@@ -0,0 +1,35 @@
+package models
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is a single problem reported by a rule. Line and Column are
+// 1-based, a zero value means the position is unknown. Coordinate holds the
+// schema coordinate of the offending definition, e.g. "User.email".
+type Finding struct {
+	FilePath    string
+	RuleID      string
+	Severity    Severity
+	Line        int
+	Column      int
+	EndLine     int
+	EndColumn   int
+	Coordinate  string
+	Message     string
+	Suggestion  string
+	LineContent string
+}
+
+// String returns the rule identifier followed by the message, which is how
+// findings are shown in the human-readable report.
+func (f Finding) String() string {
+	if f.RuleID == "" {
+		return f.Message
+	}
+
+	return f.RuleID + ": " + f.Message
+}
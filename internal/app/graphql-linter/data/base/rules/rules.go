@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"unicode"
@@ -9,79 +10,18 @@ import (
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/constants"
 	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
-	log "github.com/sirupsen/logrus"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/operationreport"
 )
 
 const (
+	descriptionErrorCapacity  = 8
 	minEnumValuesForSortCheck = 2
 	minFieldsForSortCheck     = 2
-	splitNParts               = 2
 )
 
-//nolint:interfacebloat //TODO: generate one method per rule
-type Ruler interface {
-	EnumValuesSortedAlphabetically(
-		doc *ast.Document,
-		modelsLinterConfig *models.LinterConfig,
-		schemaString string,
-		schemaPath string,
-	) []models.DescriptionError
-	FieldsAreCamelCased(doc *ast.Document, schemaString string) []models.DescriptionError
-	InputObjectFieldsSortedAlphabetically(doc *ast.Document, schemaString string) []models.DescriptionError
-	InputObjectValuesCamelCased(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingArgumentDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingDeprecationReasons(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingEnumValueDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingFieldDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingInputObjectValueDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingQueryRootType(doc *ast.Document, schemaString string) []models.DescriptionError
-	MissingTypeDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError
-	RelayConnectionArgumentsSpec(doc *ast.Document, schemaString string) []models.DescriptionError
-	RelayConnectionTypesSpec(doc *ast.Document, schemaString string) []models.DescriptionError
-	RelayPageInfoSpec(doc *ast.Document, schemaString string) []models.DescriptionError
-	ReportUncapitalizedDescription(
-		kind,
-		parent,
-		name,
-		desc,
-		schemaString string,
-	) *models.DescriptionError
-	TypesAreCapitalized(doc *ast.Document, schemaString string) []models.DescriptionError
-	UnusedTypes(doc *ast.Document, schemaString string) []models.DescriptionError
-	UnsortedFields(
-		fieldDefs []int,
-		getFieldName func(int) string,
-		typeLabel,
-		typeName,
-		schemaString string,
-	) []models.DescriptionError
-	ValidateEnumTypes(
-		doc *ast.Document,
-		modelsLinterConfig *models.LinterConfig,
-		schemaContent string,
-		schemaPath string,
-	) ([]string, []int, []models.DescriptionError)
-	ValidateFieldTypes(
-		doc *ast.Document,
-		schemaContent string,
-		builtInScalars, definedTypes map[string]bool,
-	) ([]string, []int)
-	ValidateInputFieldTypes(
-		doc *ast.Document,
-		schemaContent string,
-		builtInScalars, definedTypes map[string]bool,
-	) ([]string, []int)
-}
-
-type Rule struct{}
-
-func NewRule() *Rule {
-	return &Rule{}
-}
-
-func (r Rule) TypesAreCapitalized(doc *ast.Document, schemaString string) []models.DescriptionError {
-	errors := make([]models.DescriptionError, 0)
+func TypesAreCapitalized(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0)
 
 	for _, obj := range doc.ObjectTypeDefinitions {
 		typeName := doc.Input.ByteSliceString(obj.Name)
@@ -92,27 +32,19 @@ func (r Rule) TypesAreCapitalized(doc *ast.Document, schemaString string) []mode
 		}
 
 		if len(typeName) == 0 || !unicode.IsUpper(rune(typeName[0])) {
-			lineNum := findLineNumberByText(schemaString, "type "+typeName)
-			lineContent := GetLineContent(schemaString, lineNum)
-			message := "types-are-capitalized: The object type '" + typeName + "' should start with a capital letter."
-			errors = append(errors, models.DescriptionError{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			})
+			message := "The object type '" + typeName + "' should start with a capital letter."
+			errors = append(
+				errors,
+				newFinding(lines, obj.Name, pkg_rules.RuleTypesAreCapitalized, typeName, message),
+			)
 		}
 	}
 
 	return errors
 }
 
-func (r Rule) EnumValuesSortedAlphabetically(
-	doc *ast.Document,
-	modelsLinterConfig *models.LinterConfig,
-	schemaString string,
-	schemaPath string,
-) []models.DescriptionError {
-	var errors []models.DescriptionError
+func EnumValuesSortedAlphabetically(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, enum := range doc.EnumTypeDefinitions {
 		enumName := doc.Input.ByteSliceString(enum.Name)
@@ -125,37 +57,23 @@ func (r Rule) EnumValuesSortedAlphabetically(
 		}
 
 		if err := checkSortedOrder(
+			lines,
 			valueNames,
 			minEnumValuesForSortCheck,
-			schemaString,
-			"enum ",
+			enum.Name,
+			enumName,
 			enumName,
-			"enum-values-sorted-alphabetically",
+			pkg_rules.RuleEnumValuesSortedAlphabetically,
 		); err != nil {
-			messageParts := strings.SplitN(err.Message, ": ", splitNParts)
-
-			suppressionValue := ""
-			if len(messageParts) > 1 {
-				suppressionValue = messageParts[1]
-			}
-
-			if !pkg_rules.IsSuppressed(
-				schemaPath,
-				err.LineNum,
-				modelsLinterConfig,
-				"enum-values-sorted-alphabetically",
-				suppressionValue,
-			) {
-				errors = append(errors, *err)
-			}
+			errors = append(errors, *err)
 		}
 	}
 
 	return errors
 }
 
-func (r Rule) MissingDeprecationReasons(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func MissingDeprecationReasons(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, enum := range doc.EnumTypeDefinitions {
 		enumName := doc.Input.ByteSliceString(enum.Name)
@@ -168,15 +86,16 @@ func (r Rule) MissingDeprecationReasons(doc *ast.Document, schemaString string)
 
 				dirName := doc.Input.ByteSliceString(dir.Name)
 				if dirName == "deprecated" && len(dir.Arguments.Refs) == 0 {
-					lineNum := findLineNumberByText(schemaString, valueName)
-					lineContent := GetLineContent(schemaString, lineNum)
-					message := "deprecations-have-a-reason: Deprecated enum value '" + enumName + "." +
-						valueName + "' is missing a reason."
-					errors = append(errors, models.DescriptionError{
-						LineNum:     lineNum,
-						Message:     message,
-						LineContent: lineContent,
-					})
+					message := "Deprecated enum value '" + enumName + "." + valueName + "' is missing a reason."
+					finding := newFinding(
+						lines,
+						valueDef.EnumValue,
+						pkg_rules.RuleDeprecationsHaveAReason,
+						enumName+"."+valueName,
+						message,
+					)
+					finding.Suggestion = "Add a reason, e.g. @deprecated(reason: \"Use something else.\")"
+					errors = append(errors, finding)
 				}
 			}
 		}
@@ -185,10 +104,10 @@ func (r Rule) MissingDeprecationReasons(doc *ast.Document, schemaString string)
 	return errors
 }
 
-func (r Rule) MissingArgumentDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func MissingArgumentDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
-	for _, obj := range doc.ObjectTypeDefinitions {
+	for _, obj := range objectTypes(doc) {
 		for _, fieldRef := range obj.FieldsDefinition.Refs {
 			fieldDef := doc.FieldDefinitions[fieldRef]
 			for _, argRef := range fieldDef.ArgumentsDefinition.Refs {
@@ -196,15 +115,14 @@ func (r Rule) MissingArgumentDescriptions(doc *ast.Document, schemaString string
 				if !argDef.Description.IsDefined {
 					argName := doc.Input.ByteSliceString(argDef.Name)
 					fieldName := doc.Input.ByteSliceString(fieldDef.Name)
-					lineNum := findLineNumberByText(schemaString, argName+":")
-					lineContent := GetLineContent(schemaString, lineNum)
-					message := "arguments-have-descriptions: The '" + argName + "' argument of '" + fieldName +
-						"' is missing a description."
-					errors = append(errors, models.DescriptionError{
-						LineNum:     lineNum,
-						Message:     message,
-						LineContent: lineContent,
-					})
+					message := "The '" + argName + "' argument of '" + fieldName + "' is missing a description."
+					errors = append(errors, newFinding(
+						lines,
+						argDef.Name,
+						pkg_rules.RuleArgumentsHaveDescriptions,
+						argumentCoordinate(doc.Input.ByteSliceString(obj.Name), fieldName, argName),
+						message,
+					))
 				}
 			}
 		}
@@ -213,16 +131,18 @@ func (r Rule) MissingArgumentDescriptions(doc *ast.Document, schemaString string
 	return errors
 }
 
-func (r Rule) UnsortedFields(
+func UnsortedFields(
+	doc *ast.Document,
+	lines *LineIndex,
 	fieldDefs []int,
-	getFieldName func(int) string,
-	typeLabel,
-	typeName,
-	schemaString string,
-) []models.DescriptionError {
+	typeLabel string,
+	typeNameRef ast.ByteSliceReference,
+) []models.Finding {
+	typeName := doc.Input.ByteSliceString(typeNameRef)
+
 	fieldNames := make([]string, len(fieldDefs))
 	for i, fieldRef := range fieldDefs {
-		fieldNames[i] = getFieldName(fieldRef)
+		fieldNames[i] = doc.Input.ByteSliceString(doc.FieldDefinitions[fieldRef].Name)
 	}
 
 	if len(fieldNames) < minFieldsForSortCheck {
@@ -235,28 +155,40 @@ func (r Rule) UnsortedFields(
 
 	for i := range fieldNames {
 		if fieldNames[i] != sorted[i] {
-			lineNum := findLineNumberByText(schemaString, typeLabel+" "+typeName)
-			lineContent := GetLineContent(schemaString, lineNum)
-			message := typeLabel + "-fields-sorted-alphabetically: The fields of " +
-				typeLabel + " type `" + typeName + "` should be sorted in alphabetical order.\nExpected sorting: " +
-				strings.Join(sorted, ", ")
+			message := "The fields of " + typeLabel + " type `" + typeName +
+				"` should be sorted in alphabetical order.\nExpected sorting: " + strings.Join(sorted, ", ")
 
-			return []models.DescriptionError{{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			}}
+			return []models.Finding{
+				newFinding(lines, typeNameRef, typeLabel+"-fields-sorted-alphabetically", typeName, message),
+			}
 		}
 	}
 
 	return nil
 }
 
-func (r Rule) MissingInputObjectValueDescriptions(
-	doc *ast.Document,
-	schemaString string,
-) []models.DescriptionError {
-	var errors []models.DescriptionError
+func UnsortedTypeFields(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
+
+	for _, obj := range objectTypes(doc) {
+		errors = append(errors, UnsortedFields(doc, lines, obj.FieldsDefinition.Refs, "type", obj.Name)...)
+	}
+
+	return errors
+}
+
+func UnsortedInterfaceFields(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
+
+	for _, iface := range doc.InterfaceTypeDefinitions {
+		errors = append(errors, UnsortedFields(doc, lines, iface.FieldsDefinition.Refs, "interface", iface.Name)...)
+	}
+
+	return errors
+}
+
+func MissingInputObjectValueDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, input := range doc.InputObjectTypeDefinitions {
 		inputName := doc.Input.ByteSliceString(input.Name)
@@ -264,18 +196,18 @@ func (r Rule) MissingInputObjectValueDescriptions(
 			fieldDef := doc.InputValueDefinitions[fieldRef]
 			if !fieldDef.Description.IsDefined {
 				fieldName := doc.Input.ByteSliceString(fieldDef.Name)
-				lineNum := findLineNumberByText(schemaString, fieldName+":")
-				lineContent := GetLineContent(schemaString, lineNum)
 				message := fmt.Sprintf(
-					"input-object-values-have-descriptions: The input value `%s.%s` is missing a description.",
+					"The input value `%s.%s` is missing a description.",
 					inputName,
 					fieldName,
 				)
-				errors = append(errors, models.DescriptionError{
-					LineNum:     lineNum,
-					Message:     message,
-					LineContent: lineContent,
-				})
+				errors = append(errors, newFinding(
+					lines,
+					fieldDef.Name,
+					pkg_rules.RuleInputObjectValuesHaveDescriptions,
+					inputName+"."+fieldName,
+					message,
+				))
 			}
 		}
 	}
@@ -283,11 +215,8 @@ func (r Rule) MissingInputObjectValueDescriptions(
 	return errors
 }
 
-func (r Rule) InputObjectFieldsSortedAlphabetically(
-	doc *ast.Document,
-	schemaString string,
-) []models.DescriptionError {
-	var errors []models.DescriptionError
+func InputObjectFieldsSortedAlphabetically(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, input := range doc.InputObjectTypeDefinitions {
 		inputName := doc.Input.ByteSliceString(input.Name)
@@ -300,12 +229,13 @@ func (r Rule) InputObjectFieldsSortedAlphabetically(
 		}
 
 		if err := checkSortedOrder(
+			lines,
 			fieldNames,
 			minFieldsForSortCheck,
-			schemaString,
-			"input ",
+			input.Name,
 			"fields of input type '"+inputName+"'",
-			"input-object-fields-sorted-alphabetically",
+			inputName,
+			pkg_rules.RuleInputObjectFieldsSortedAlphabetically,
 		); err != nil {
 			errors = append(errors, *err)
 		}
@@ -314,10 +244,10 @@ func (r Rule) InputObjectFieldsSortedAlphabetically(
 	return errors
 }
 
-func (r Rule) FieldsAreCamelCased(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func FieldsAreCamelCased(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
-	for _, obj := range doc.ObjectTypeDefinitions {
+	for _, obj := range objectTypes(doc) {
 		typeName := doc.Input.ByteSliceString(obj.Name)
 
 		for _, fieldRef := range obj.FieldsDefinition.Refs {
@@ -325,14 +255,16 @@ func (r Rule) FieldsAreCamelCased(doc *ast.Document, schemaString string) []mode
 
 			fieldName := doc.Input.ByteSliceString(fieldDef.Name)
 			if !isCamelCase(fieldName) {
-				lineNum := findFieldDefinitionLine(schemaString, fieldName, "")
-				lineContent := GetLineContent(schemaString, lineNum)
-				message := "fields-are-camel-cased: The field '" + typeName + "." + fieldName + "' is not camel cased."
-				errors = append(errors, models.DescriptionError{
-					LineNum:     lineNum,
-					Message:     message,
-					LineContent: lineContent,
-				})
+				message := "The field '" + typeName + "." + fieldName + "' is not camel cased."
+				finding := newFinding(
+					lines,
+					fieldDef.Name,
+					pkg_rules.RuleFieldsAreCamelCased,
+					typeName+"."+fieldName,
+					message,
+				)
+				finding.Suggestion = camelCaseSuggestion(fieldName)
+				errors = append(errors, finding)
 			}
 		}
 	}
@@ -340,8 +272,8 @@ func (r Rule) FieldsAreCamelCased(doc *ast.Document, schemaString string) []mode
 	return errors
 }
 
-func (r Rule) InputObjectValuesCamelCased(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func InputObjectValuesCamelCased(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, input := range doc.InputObjectTypeDefinitions {
 		inputName := doc.Input.ByteSliceString(input.Name)
@@ -351,15 +283,16 @@ func (r Rule) InputObjectValuesCamelCased(doc *ast.Document, schemaString string
 
 			fieldName := doc.Input.ByteSliceString(fieldDef.Name)
 			if !isCamelCase(fieldName) {
-				lineNum := findLineNumberByText(schemaString, fieldName+":")
-				lineContent := GetLineContent(schemaString, lineNum)
-				message := "input-object-values-are-camel-cased: The input value `" +
-					inputName + "." + fieldName + "` is not camel cased."
-				errors = append(errors, models.DescriptionError{
-					LineNum:     lineNum,
-					Message:     message,
-					LineContent: lineContent,
-				})
+				message := "The input value `" + inputName + "." + fieldName + "` is not camel cased."
+				finding := newFinding(
+					lines,
+					fieldDef.Name,
+					pkg_rules.RuleInputObjectValuesAreCamelCased,
+					inputName+"."+fieldName,
+					message,
+				)
+				finding.Suggestion = camelCaseSuggestion(fieldName)
+				errors = append(errors, finding)
 			}
 		}
 	}
@@ -367,7 +300,7 @@ func (r Rule) InputObjectValuesCamelCased(doc *ast.Document, schemaString string
 	return errors
 }
 
-func (r Rule) RelayPageInfoSpec(doc *ast.Document, schemaString string) []models.DescriptionError {
+func RelayPageInfoSpec(doc *ast.Document, lines *LineIndex) []models.Finding {
 	for _, obj := range doc.ObjectTypeDefinitions {
 		if doc.Input.ByteSliceString(obj.Name) == "PageInfo" {
 			return nil
@@ -375,23 +308,25 @@ func (r Rule) RelayPageInfoSpec(doc *ast.Document, schemaString string) []models
 	}
 
 	lineNum := 1
-	lineContent := GetLineContent(schemaString, lineNum)
-	message := "relay-page-info-spec: A `PageInfo` object type is required as per the Relay spec."
-
-	return []models.DescriptionError{{
-		LineNum:     lineNum,
+	lineContent := lines.Content(lineNum)
+	message := "A `PageInfo` object type is required as per the Relay spec."
+
+	return []models.Finding{{
+		RuleID:      pkg_rules.RuleRelayPageInfoSpec,
+		Severity:    models.SeverityError,
+		Line:        lineNum,
+		Coordinate:  "PageInfo",
 		Message:     message,
 		LineContent: lineContent,
 	}}
 }
 
-func (r Rule) RelayConnectionArgumentsSpec(
-	doc *ast.Document,
-	schemaString string,
-) []models.DescriptionError {
-	var errors []models.DescriptionError
+func RelayConnectionArgumentsSpec(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
+
+	parents := FieldParents(doc)
 
-	for _, fieldDef := range doc.FieldDefinitions {
+	for fieldRef, fieldDef := range doc.FieldDefinitions {
 		fieldType := doc.Types[fieldDef.Type]
 		baseType := getBaseTypeName(doc, fieldType)
 
@@ -416,24 +351,26 @@ func (r Rule) RelayConnectionArgumentsSpec(
 
 		if !hasForwardArgs && !hasBackwardArgs {
 			fieldName := doc.Input.ByteSliceString(fieldDef.Name)
-			lineNum := findFieldDefinitionLine(schemaString, fieldName, "")
-			lineContent := GetLineContent(schemaString, lineNum)
-			message := "relay-connection-arguments-spec: A field that returns a Connection Type must include forward" +
+			message := "A field that returns a Connection Type must include forward" +
 				"pagination arguments (`first` and `after`), backward pagination arguments (`last` and `before`), or both as" +
 				"per the Relay spec."
-			errors = append(errors, models.DescriptionError{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			})
+			finding := newFinding(
+				lines,
+				fieldDef.Name,
+				pkg_rules.RuleRelayConnectionArgumentsSpec,
+				FieldCoordinate(parents, fieldRef, fieldName),
+				message,
+			)
+			finding.Suggestion = "Add the arguments `first: Int, after: String` and/or `last: Int, before: String`."
+			errors = append(errors, finding)
 		}
 	}
 
 	return errors
 }
 
-func (r Rule) RelayConnectionTypesSpec(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func RelayConnectionTypesSpec(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, obj := range doc.ObjectTypeDefinitions {
 		typeName := doc.Input.ByteSliceString(obj.Name)
@@ -457,38 +394,33 @@ func (r Rule) RelayConnectionTypesSpec(doc *ast.Document, schemaString string) [
 			}
 		}
 
-		lineNum := findLineNumberByText(schemaString, "type "+typeName)
-		lineContent := GetLineContent(schemaString, lineNum)
-
 		if !hasPageInfo {
 			message := fmt.Sprintf(
-				"relay-connection-types-spec: Connection `%s` is missing the following field: pageInfo.",
+				"Connection `%s` is missing the following field: pageInfo.",
 				typeName,
 			)
-			errors = append(errors, models.DescriptionError{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			})
+			errors = append(
+				errors,
+				newFinding(lines, obj.Name, pkg_rules.RuleRelayConnectionTypesSpec, typeName, message),
+			)
 		}
 
 		if !hasEdges {
 			message := fmt.Sprintf(
-				"relay-connection-types-spec: Connection `%s` is missing the following field: edges.",
+				"Connection `%s` is missing the following field: edges.",
 				typeName,
 			)
-			errors = append(errors, models.DescriptionError{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			})
+			errors = append(
+				errors,
+				newFinding(lines, obj.Name, pkg_rules.RuleRelayConnectionTypesSpec, typeName, message),
+			)
 		}
 	}
 
 	return errors
 }
 
-func (r Rule) MissingQueryRootType(doc *ast.Document, schemaString string) []models.DescriptionError {
+func MissingQueryRootType(doc *ast.Document, lines *LineIndex) []models.Finding {
 	for _, obj := range doc.ObjectTypeDefinitions {
 		if doc.Input.ByteSliceString(obj.Name) == "Query" {
 			return nil
@@ -496,21 +428,54 @@ func (r Rule) MissingQueryRootType(doc *ast.Document, schemaString string) []mod
 	}
 
 	lineNum := 1
-	lineContent := GetLineContent(schemaString, lineNum)
-	message := "invalid-graphql-schema: Query root type must be provided."
-
-	return []models.DescriptionError{{
-		LineNum:     lineNum,
+	lineContent := lines.Content(lineNum)
+	message := "Query root type must be provided."
+
+	return []models.Finding{{
+		RuleID:      pkg_rules.RuleMissingQueryRootType,
+		Severity:    models.SeverityError,
+		Line:        lineNum,
+		Coordinate:  "Query",
 		Message:     message,
 		LineContent: lineContent,
 	}}
 }
 
-func (r Rule) MissingEnumValueDescriptions(
-	doc *ast.Document,
-	schemaString string,
-) []models.DescriptionError {
-	var errors []models.DescriptionError
+// ParseErrors turns the errors of parsing a schema into findings, so that a
+// schema that is not valid GraphQL is reported like any other problem.
+func ParseErrors(schemaString string, parseReport *operationreport.Report) []models.Finding {
+	findings := make([]models.Finding, 0, len(parseReport.ExternalErrors)+len(parseReport.InternalErrors))
+	lines := NewLineIndex([]byte(schemaString))
+
+	for _, externalErr := range parseReport.ExternalErrors {
+		finding := models.Finding{
+			RuleID:   pkg_rules.RuleInvalidGraphQLSchema,
+			Severity: models.SeverityError,
+			Message:  "Syntax error: " + externalErr.Message,
+		}
+
+		if len(externalErr.Locations) > 0 {
+			finding.Line = int(externalErr.Locations[0].Line)
+			finding.Column = int(externalErr.Locations[0].Column)
+			finding.LineContent = lines.Content(finding.Line)
+		}
+
+		findings = append(findings, finding)
+	}
+
+	for _, internalErr := range parseReport.InternalErrors {
+		findings = append(findings, models.Finding{
+			RuleID:   pkg_rules.RuleInvalidGraphQLSchema,
+			Severity: models.SeverityError,
+			Message:  "Unable to parse the schema: " + internalErr.Error(),
+		})
+	}
+
+	return findings
+}
+
+func MissingEnumValueDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, enum := range doc.EnumTypeDefinitions {
 		enumName := doc.Input.ByteSliceString(enum.Name)
@@ -518,15 +483,14 @@ func (r Rule) MissingEnumValueDescriptions(
 			valueDef := doc.EnumValueDefinitions[valueRef]
 			if !valueDef.Description.IsDefined {
 				valueName := doc.Input.ByteSliceString(valueDef.EnumValue)
-				lineNum := findLineNumberByText(schemaString, valueName)
-				lineContent := GetLineContent(schemaString, lineNum)
-				message := "enum-values-have-descriptions: Enum value '" + enumName + "." + valueName +
-					"' is missing a description."
-				errors = append(errors, models.DescriptionError{
-					LineNum:     lineNum,
-					Message:     message,
-					LineContent: lineContent,
-				})
+				message := "Enum value '" + enumName + "." + valueName + "' is missing a description."
+				errors = append(errors, newFinding(
+					lines,
+					valueDef.EnumValue,
+					pkg_rules.RuleEnumValuesHaveDescriptions,
+					enumName+"."+valueName,
+					message,
+				))
 			}
 		}
 	}
@@ -534,43 +498,40 @@ func (r Rule) MissingEnumValueDescriptions(
 	return errors
 }
 
-func (r Rule) MissingTypeDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func MissingTypeDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
 	for _, obj := range doc.ObjectTypeDefinitions {
 		if !obj.Description.IsDefined {
 			name := doc.Input.ByteSliceString(obj.Name)
-			lineNum := findLineNumberByText(schemaString, "type "+name)
-			lineContent := GetLineContent(schemaString, lineNum)
-			message := "types-have-descriptions: Object type '" + name + "' is missing a description"
-			errors = append(errors, models.DescriptionError{
-				LineNum:     lineNum,
-				Message:     message,
-				LineContent: lineContent,
-			})
+			message := "Object type '" + name + "' is missing a description"
+			errors = append(
+				errors,
+				newFinding(lines, obj.Name, pkg_rules.RuleTypesHaveDescriptions, name, message),
+			)
 		}
 	}
 
 	return errors
 }
 
-func (r Rule) MissingFieldDescriptions(doc *ast.Document, schemaString string) []models.DescriptionError {
-	var errors []models.DescriptionError
+func MissingFieldDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
-	for _, obj := range doc.ObjectTypeDefinitions {
+	for _, obj := range objectTypes(doc) {
 		typeName := doc.Input.ByteSliceString(obj.Name)
 		for _, fieldRef := range obj.FieldsDefinition.Refs {
 			fieldDef := doc.FieldDefinitions[fieldRef]
 			if !fieldDef.Description.IsDefined {
 				fieldName := doc.Input.ByteSliceString(fieldDef.Name)
-				lineNum := findFieldDefinitionLine(schemaString, fieldName, "")
-				lineContent := GetLineContent(schemaString, lineNum)
-				message := "fields-have-descriptions: Field '" + typeName + "." + fieldName + "' is missing a description."
-				errors = append(errors, models.DescriptionError{
-					LineNum:     lineNum,
-					Message:     message,
-					LineContent: lineContent,
-				})
+				message := "Field '" + typeName + "." + fieldName + "' is missing a description."
+				errors = append(errors, newFinding(
+					lines,
+					fieldDef.Name,
+					pkg_rules.RuleFieldsHaveDescriptions,
+					typeName+"."+fieldName,
+					message,
+				))
 			}
 		}
 	}
@@ -578,90 +539,191 @@ func (r Rule) MissingFieldDescriptions(doc *ast.Document, schemaString string) [
 	return errors
 }
 
-func (r Rule) ReportUncapitalizedDescription(
+// ReportUncapitalizedDescription returns a finding when the description of the
+// definition at nameRef, whose schema coordinate is coordinate, does not start
+// with a capital letter.
+func ReportUncapitalizedDescription(
+	lines *LineIndex,
 	kind,
-	parent,
-	name,
-	desc,
-	schemaString string,
-) *models.DescriptionError {
+	coordinate string,
+	nameRef ast.ByteSliceReference,
+	desc string,
+) *models.Finding {
 	if isCapitalized(desc) {
 		return nil
 	}
 
-	var (
-		lineNum     int
-		lineContent string
-		message     string
-	)
+	var message string
 
 	switch kind {
 	case "type":
-		lineNum = findLineNumberByText(schemaString, "type "+name)
-		lineContent = GetLineContent(schemaString, lineNum)
-		message = "descriptions-are-capitalized: The description for type `" + name + "` should be capitalized."
+		message = "The description for type `" + coordinate + "` should be capitalized."
 	case "field":
-		lineNum = findFieldDefinitionLine(schemaString, name, "")
-		lineContent = GetLineContent(schemaString, lineNum)
-		message = "descriptions-are-capitalized: The description for field `" + parent + "." + name +
-			"` should be capitalized."
+		message = "The description for field `" + coordinate + "` should be capitalized."
 	case "enum":
-		lineNum = findLineNumberByText(schemaString, name)
-		lineContent = GetLineContent(schemaString, lineNum)
-		message = "descriptions-are-capitalized: The description for enum value `" + parent + "." + name +
-			"` should be capitalized."
+		message = "The description for enum value `" + coordinate + "` should be capitalized."
 	case "argument":
-		lineNum = findLineNumberByText(schemaString, name+":")
-		lineContent = GetLineContent(schemaString, lineNum)
-		message = "descriptions-are-capitalized: The description for argument `" + parent + "." + name +
-			"` should be capitalized."
+		message = "The description for argument `" + coordinate + "` should be capitalized."
 	}
 
-	return &models.DescriptionError{
-		LineNum:     lineNum,
-		Message:     message,
-		LineContent: lineContent,
+	finding := newFinding(lines, nameRef, pkg_rules.RuleDescriptionsAreCapitalized, coordinate, message)
+
+	return &finding
+}
+
+func UncapitalizedDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0, pkg_rules.DefaultErrorCapacity)
+	errors = append(errors, uncapitalizedTypeDescriptions(doc, lines)...)
+	errors = append(errors, uncapitalizedFieldDescriptions(doc, lines)...)
+	errors = append(errors, uncapitalizedEnumValueDescriptions(doc, lines)...)
+	errors = append(errors, uncapitalizedArgumentDescriptions(doc, lines)...)
+
+	return errors
+}
+
+func uncapitalizedTypeDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0, descriptionErrorCapacity)
+
+	for _, obj := range doc.ObjectTypeDefinitions {
+		if obj.Description.IsDefined {
+			desc := doc.Input.ByteSliceString(obj.Description.Content)
+
+			err := ReportUncapitalizedDescription(lines, "type", doc.Input.ByteSliceString(obj.Name), obj.Name, desc)
+			if err != nil {
+				errors = append(errors, *err)
+			}
+		}
 	}
+
+	return errors
 }
 
-func (r Rule) UnusedTypes(doc *ast.Document, schemaString string) []models.DescriptionError {
-	definedTypes := collectDefinedTypeNames(doc)
+func uncapitalizedFieldDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0, descriptionErrorCapacity)
+
+	for _, obj := range objectTypes(doc) {
+		typeName := doc.Input.ByteSliceString(obj.Name)
+
+		for _, fieldRef := range obj.FieldsDefinition.Refs {
+			fieldDef := doc.FieldDefinitions[fieldRef]
+			if fieldDef.Description.IsDefined {
+				desc := doc.Input.ByteSliceString(fieldDef.Description.Content)
+
+				err := ReportUncapitalizedDescription(
+					lines,
+					"field",
+					typeName+"."+doc.Input.ByteSliceString(fieldDef.Name),
+					fieldDef.Name,
+					desc,
+				)
+				if err != nil {
+					errors = append(errors, *err)
+				}
+			}
+		}
+	}
+
+	return errors
+}
+
+func uncapitalizedEnumValueDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0, descriptionErrorCapacity)
+
+	for _, enum := range doc.EnumTypeDefinitions {
+		enumName := doc.Input.ByteSliceString(enum.Name)
+
+		for _, valueRef := range enum.EnumValuesDefinition.Refs {
+			valueDef := doc.EnumValueDefinitions[valueRef]
+			if valueDef.Description.IsDefined {
+				desc := doc.Input.ByteSliceString(valueDef.Description.Content)
+
+				err := ReportUncapitalizedDescription(
+					lines,
+					"enum",
+					enumName+"."+doc.Input.ByteSliceString(valueDef.EnumValue),
+					valueDef.EnumValue,
+					desc,
+				)
+				if err != nil {
+					errors = append(errors, *err)
+				}
+			}
+		}
+	}
+
+	return errors
+}
+
+func uncapitalizedArgumentDescriptions(doc *ast.Document, lines *LineIndex) []models.Finding {
+	errors := make([]models.Finding, 0, descriptionErrorCapacity)
+
+	for _, obj := range objectTypes(doc) {
+		typeName := doc.Input.ByteSliceString(obj.Name)
+
+		for _, fieldRef := range obj.FieldsDefinition.Refs {
+			fieldDef := doc.FieldDefinitions[fieldRef]
+			for _, argRef := range fieldDef.ArgumentsDefinition.Refs {
+				argDef := doc.InputValueDefinitions[argRef]
+				if argDef.Description.IsDefined {
+					desc := doc.Input.ByteSliceString(argDef.Description.Content)
+					fieldName := doc.Input.ByteSliceString(fieldDef.Name)
+
+					err := ReportUncapitalizedDescription(
+						lines,
+						"argument",
+						argumentCoordinate(typeName, fieldName, doc.Input.ByteSliceString(argDef.Name)),
+						argDef.Name,
+						desc,
+					)
+					if err != nil {
+						errors = append(errors, *err)
+					}
+				}
+			}
+		}
+	}
+
+	return errors
+}
 
-	unusedTypeErrors := make([]models.DescriptionError, 0, len(definedTypes))
+func UnusedTypes(doc *ast.Document, lines *LineIndex) []models.Finding {
+	definedTypes := collectDefinedTypeNames(doc)
+	nameRefs := typeNameRefs(doc)
 
 	markUsedTypes(doc, definedTypes)
 
+	unusedTypeNames := make([]string, 0, len(definedTypes))
+
 	for typeName, isUsed := range definedTypes {
-		if isUsed {
-			continue
+		if !isUsed {
+			unusedTypeNames = append(unusedTypeNames, typeName)
 		}
+	}
+
+	sort.Slice(unusedTypeNames, func(i, j int) bool {
+		return nameRefs[unusedTypeNames[i]].Start < nameRefs[unusedTypeNames[j]].Start
+	})
 
-		lineNum := findTypeLineNumber(typeName, schemaString)
-		lineContent := GetLineContent(schemaString, lineNum)
+	unusedTypeErrors := make([]models.Finding, 0, len(unusedTypeNames))
+
+	for _, typeName := range unusedTypeNames {
 		message := fmt.Sprintf(
-			"defined-types-are-used: Type '%s' is defined but not used",
+			"Type '%s' is defined but not used",
 			typeName,
 		)
-		unusedTypeErrors = append(unusedTypeErrors, models.DescriptionError{
-			LineNum:     lineNum,
-			Message:     message,
-			LineContent: lineContent,
-		})
+		finding := newFinding(lines, nameRefs[typeName], pkg_rules.RuleDefinedTypesAreUsed, typeName, message)
+		finding.Suggestion = "Remove the type or reference it from a field, argument or union."
+		unusedTypeErrors = append(unusedTypeErrors, finding)
 	}
 
 	return unusedTypeErrors
 }
 
-func (r Rule) ValidateEnumTypes(
-	doc *ast.Document,
-	modelsLinterConfig *models.LinterConfig,
-	schemaContent string,
-	schemaPath string,
-) ([]string, []int, []models.DescriptionError) {
+func ValidateEnumTypes(doc *ast.Document, lines *LineIndex) ([]string, []int, []models.Finding) {
 	var (
 		errors     []string
 		errorLines []int
-		descErrors []models.DescriptionError
+		descErrors []models.Finding
 	)
 
 	for _, enumDef := range doc.EnumTypeDefinitions {
@@ -670,34 +732,28 @@ func (r Rule) ValidateEnumTypes(
 		for _, valueRef := range enumDef.EnumValuesDefinition.Refs {
 			valueDef := doc.EnumValueDefinitions[valueRef]
 			valueName := doc.Input.ByteSliceString(valueDef.EnumValue)
+			valueLine, _ := lines.position(valueDef.EnumValue.Start)
 
-			if errValue, line := checkInvalidEnumValue(enumName, valueName, schemaContent); errValue != "" {
+			if errValue, line := checkInvalidEnumValue(enumName, valueName, valueLine); errValue != "" {
 				errors = append(errors, errValue)
 				if line > 0 {
 					errorLines = append(errorLines, line)
 				}
 			}
 
-			if errValue, line := checkSuspiciousEnumValue(
-				enumName,
-				valueName,
-				schemaContent,
-				schemaPath,
-				modelsLinterConfig,
-			); errValue != "" {
+			if errValue, line := checkSuspiciousEnumValue(valueName, valueLine); errValue != "" {
 				errors = append(errors, errValue)
 				if line > 0 {
 					errorLines = append(errorLines, line)
-					descErrors = append(descErrors, models.DescriptionError{
-						FilePath: schemaPath,
-						LineNum:  line,
-						Message: fmt.Sprintf(
-							"suspicious-enum-value: Enum '%s' has suspicious value '%s'",
-							enumName,
-							errValue,
-						),
-						LineContent: GetLineContent(schemaContent, line),
-					})
+					finding := newFinding(
+						lines,
+						valueDef.EnumValue,
+						pkg_rules.RuleSuspiciousEnumValue,
+						enumName+"."+valueName,
+						fmt.Sprintf("Enum '%s' has suspicious value '%s'", enumName, errValue),
+					)
+					finding.Suggestion = enumValueSuggestion(valueName)
+					descErrors = append(descErrors, finding)
 				}
 			}
 		}
@@ -706,144 +762,89 @@ func (r Rule) ValidateEnumTypes(
 	return errors, errorLines, descErrors
 }
 
-func (r Rule) ValidateFieldTypes(
-	doc *ast.Document,
-	schemaContent string,
-	builtInScalars, definedTypes map[string]bool,
-) ([]string, []int) {
-	return validateTypeReferences(
-		doc,
-		schemaContent,
-		builtInScalars,
-		definedTypes,
-		indexSlice(len(doc.FieldDefinitions)),
-		func(i int) string { return doc.Input.ByteSliceString(doc.FieldDefinitions[i].Name) },
-		func(i int) ast.Type { return doc.Types[doc.FieldDefinitions[i].Type] },
-		"invalid-field-types: Field",
-	)
-}
+// UndefinedTypes returns a finding for every field, argument and input field
+// whose type is neither a built-in scalar nor defined in the schema.
+func UndefinedTypes(doc *ast.Document, lines *LineIndex) []models.Finding {
+	var errors []models.Finding
 
-func (r Rule) ValidateInputFieldTypes(
-	doc *ast.Document,
-	schemaContent string,
-	builtInScalars, definedTypes map[string]bool,
-) ([]string, []int) {
-	return validateTypeReferences(
-		doc,
-		schemaContent,
-		builtInScalars,
-		definedTypes,
-		indexSlice(len(doc.InputValueDefinitions)),
-		func(i int) string { return doc.Input.ByteSliceString(doc.InputValueDefinitions[i].Name) },
-		func(i int) ast.Type { return doc.Types[doc.InputValueDefinitions[i].Type] },
-		"invalid-input-field-types: Input field",
-	)
-}
+	availableTypes := getAvailableTypes(builtInScalars, CollectDefinedTypes(doc))
+	parents := FieldParents(doc)
 
-func checkInvalidEnumValue(enumName, valueName, schemaContent string) (string, int) {
-	if isValidEnumValue(valueName) {
-		return "", 0
+	report := func(kind, coordinate string, nameRef ast.ByteSliceReference, typeRef int) {
+		finding := undefinedType(doc, lines, availableTypes, kind, coordinate, nameRef, doc.Types[typeRef])
+		if finding != nil {
+			errors = append(errors, *finding)
+		}
 	}
 
-	lineNum := findLineNumberByText(schemaContent, valueName)
-	log.Infof(
-		"invalid-enum-value: Enum '%s' has invalid value '%s' (line %d)\n",
-		enumName,
-		valueName,
-		lineNum,
-	)
-	log.Infof(
-		"  Enum values should be valid GraphQL identifiers (letters, digits, underscores, no leading digits)\n",
-	)
-
-	return valueName, lineNum
-}
-
-func checkSuspiciousEnumValue(
-	enumName,
-	valueName,
-	schemaContent,
-	schemaPath string,
-	modelsLinterConfig *models.LinterConfig,
-) (string, int) {
-	if !hasSuspiciousEnumValue(valueName) &&
-		!hasEmbeddedDigits(valueName) {
-		return "", 0
-	}
+	for fieldRef, fieldDef := range doc.FieldDefinitions {
+		fieldName := doc.Input.ByteSliceString(fieldDef.Name)
+		report("Field", FieldCoordinate(parents, fieldRef, fieldName), fieldDef.Name, fieldDef.Type)
 
-	lineNum := findLineNumberByText(schemaContent, valueName)
-	if pkg_rules.IsSuppressed(
-		schemaPath,
-		lineNum,
-		modelsLinterConfig,
-		"suspicious-enum-value",
-		valueName,
-	) {
-		return "", 0
+		for _, argRef := range fieldDef.ArgumentsDefinition.Refs {
+			argDef := doc.InputValueDefinitions[argRef]
+			coordinate := argumentCoordinate(parents[fieldRef], fieldName, doc.Input.ByteSliceString(argDef.Name))
+			report("Argument", coordinate, argDef.Name, argDef.Type)
+		}
 	}
 
-	log.Errorf(
-		"suspicious-enum-value: Enum '%s' has suspicious value '%s' (line %d)\n",
-		enumName,
-		valueName,
-		lineNum,
-	)
+	for _, input := range doc.InputObjectTypeDefinitions {
+		inputName := doc.Input.ByteSliceString(input.Name)
 
-	if suggestion := suggestCorrectEnumValue(valueName); suggestion != "" {
-		log.Errorf("  Did you mean '%s'?\n", suggestion)
-	} else {
-		suggestedValue := removeSuffixDigits(valueName)
-		log.Errorf("  Did you mean '%s'? Enum values typically don't contain numbers.\n", suggestedValue)
+		for _, valueRef := range input.InputFieldsDefinition.Refs {
+			valueDef := doc.InputValueDefinitions[valueRef]
+			coordinate := inputName + "." + doc.Input.ByteSliceString(valueDef.Name)
+			report("Input field", coordinate, valueDef.Name, valueDef.Type)
+		}
 	}
 
-	return valueName, lineNum
+	return errors
 }
 
-func validateTypeReferences(
+// undefinedType returns a finding when the named type that typeRef wraps is
+// not one of the available types.
+func undefinedType(
 	doc *ast.Document,
-	schemaContent string,
-	builtInScalars, definedTypes map[string]bool,
-	typeRefs []int,
-	getName func(int) string,
-	getType func(int) ast.Type,
-	errorPrefix string,
-) ([]string, []int) {
-	var (
-		errors     []string
-		errorLines []int
-	)
+	lines *LineIndex,
+	availableTypes []string,
+	kind,
+	coordinate string,
+	nameRef ast.ByteSliceReference,
+	typeRef ast.Type,
+) *models.Finding {
+	typeName := getBaseTypeName(doc, typeRef)
+	if slices.Contains(availableTypes, typeName) {
+		return nil
+	}
 
-	for _, ref := range typeRefs {
-		fieldName := getName(ref)
-		fieldType := getType(ref)
+	finding := newFinding(
+		lines,
+		nameRef,
+		pkg_rules.RuleReferencedTypesAreDefined,
+		coordinate,
+		fmt.Sprintf("%s '%s' references undefined type '%s'.", kind, coordinate, typeName),
+	)
 
-		baseType := getBaseTypeName(doc, fieldType)
-		if !builtInScalars[baseType] && !definedTypes[baseType] {
-			lineNum := findFieldDefinitionLine(schemaContent, fieldName, baseType)
-			if lineNum == 0 {
-				lineNum = findLineNumberByText(schemaContent, fieldName+": "+baseType)
-			}
+	if closest := pkg_rules.ClosestMatch(typeName, availableTypes); closest != "" {
+		finding.Suggestion = "Did you mean '" + closest + "'?"
+	}
 
-			if lineNum == 0 {
-				lineNum = findLineNumberByText(schemaContent, fieldName+":")
-			}
+	return &finding
+}
 
-			log.Errorf(
-				"%s '%s' references undefined type '%s' (line %d)\n",
-				errorPrefix,
-				fieldName,
-				baseType,
-				lineNum,
-			)
-			log.Errorf("  Available types: %v\n", getAvailableTypes(builtInScalars, definedTypes))
+func checkInvalidEnumValue(enumName, valueName string, lineNum int) (string, int) {
+	if isValidEnumValue(valueName) {
+		return "", 0
+	}
 
-			if lineNum > 0 {
-				errorLines = append(errorLines, lineNum)
-			}
+	return valueName, lineNum
+}
 
-			errors = append(errors, fieldName)
-		}
+func checkSuspiciousEnumValue(valueName string, lineNum int) (string, int) {
+	if !hasSuspiciousEnumValue(valueName) &&
+		!hasEmbeddedDigits(valueName) {
+		return "", 0
 	}
 
-	return errors, errorLines
+	return valueName, lineNum
 }
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/constants"
@@ -11,39 +12,86 @@ import (
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
 )
 
-func findLineNumberByText(schemaContent string, searchText string) int {
-	lines := strings.Split(schemaContent, "\n")
+// LineIndex holds the offsets at which the lines of a schema start, so that
+// the rules can look up the position of a definition without scanning the
+// schema again for every finding.
+type LineIndex struct {
+	source []byte
+	starts []int
+}
+
+// NewLineIndex indexes the lines of source.
+func NewLineIndex(source []byte) *LineIndex {
+	starts := []int{0}
 
-	for i, line := range lines {
-		if strings.Contains(line, searchText) {
-			return i + 1
+	for offset, char := range source {
+		if char == '\n' {
+			starts = append(starts, offset+1)
 		}
 	}
 
-	return 0
+	return &LineIndex{source: source, starts: starts}
+}
+
+// position converts a byte offset into the source to a 1-based line and
+// column, where the column counts characters rather than bytes.
+func (l *LineIndex) position(offset uint32) (int, int) {
+	end := min(int(offset), len(l.source))
+	line := sort.Search(len(l.starts), func(i int) bool { return l.starts[i] > end })
+	column := utf8.RuneCount(l.source[l.starts[line-1]:end]) + 1
+
+	return line, column
 }
 
-func GetLineContent(schemaContent string, lineNum int) string {
-	if lineNum <= 0 {
+// Content returns the line with the given 1-based number without its leading
+// and trailing whitespace, or an empty string when there is no such line.
+func (l *LineIndex) Content(line int) string {
+	if line <= 0 || line > len(l.starts) {
 		return ""
 	}
 
-	lines := strings.Split(schemaContent, "\n")
-	if lineNum > len(lines) {
-		return ""
+	end := len(l.source)
+	if line < len(l.starts) {
+		end = l.starts[line] - 1
 	}
 
-	return strings.TrimSpace(lines[lineNum-1])
+	return strings.TrimSpace(string(l.source[l.starts[line-1]:end]))
+}
+
+// newFinding returns an error finding that spans the given name reference,
+// which is how every rule points at the offending definition.
+func newFinding(
+	lines *LineIndex,
+	nameRef ast.ByteSliceReference,
+	ruleID,
+	coordinate,
+	message string,
+) models.Finding {
+	line, column := lines.position(nameRef.Start)
+	endLine, endColumn := lines.position(nameRef.End)
+
+	return models.Finding{
+		RuleID:      ruleID,
+		Severity:    models.SeverityError,
+		Line:        line,
+		Column:      column,
+		EndLine:     endLine,
+		EndColumn:   endColumn,
+		Coordinate:  coordinate,
+		Message:     message,
+		LineContent: lines.Content(line),
+	}
 }
 
 func checkSortedOrder(
+	lines *LineIndex,
 	names []string,
 	minLength int,
-	schemaString,
-	searchPrefix,
+	nameRef ast.ByteSliceReference,
 	itemName,
-	rulePrefix string,
-) *models.DescriptionError {
+	coordinate,
+	ruleID string,
+) *models.Finding {
 	if len(names) < minLength {
 		return nil
 	}
@@ -53,19 +101,13 @@ func checkSortedOrder(
 	sort.Strings(sorted)
 
 	if !equalStringSlices(names, sorted) {
-		lineNum := findLineNumberByText(schemaString, searchPrefix+itemName)
-		lineContent := GetLineContent(schemaString, lineNum)
-		message := rulePrefix + ": The " + itemName +
-			" should be sorted in alphabetical order. Expected sorting: " + strings.Join(
+		message := "The " + itemName + " should be sorted in alphabetical order. Expected sorting: " + strings.Join(
 			sorted,
 			", ",
 		)
+		finding := newFinding(lines, nameRef, ruleID, coordinate, message)
 
-		return &models.DescriptionError{
-			LineNum:     lineNum,
-			Message:     message,
-			LineContent: lineContent,
-		}
+		return &finding
 	}
 
 	return nil
@@ -97,6 +139,32 @@ func isCamelCase(str string) bool {
 	return str[0] >= 'a' && str[0] <= 'z'
 }
 
+// camelCaseSuggestion proposes a camelCased spelling of the given name, or
+// returns an empty string if there is nothing to propose.
+func camelCaseSuggestion(name string) string {
+	var result strings.Builder
+
+	for index, part := range strings.Split(strings.Trim(name, "_"), "_") {
+		if part == "" {
+			continue
+		}
+
+		if index == 0 || result.Len() == 0 {
+			result.WriteString(strings.ToLower(part[:1]) + part[1:])
+
+			continue
+		}
+
+		result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	if result.Len() == 0 || result.String() == name {
+		return ""
+	}
+
+	return "Rename it to '" + result.String() + "'."
+}
+
 func isCapitalized(desc string) bool {
 	desc = strings.TrimSpace(desc)
 	if desc == "" {
@@ -150,6 +218,14 @@ func suggestCorrectEnumValue(value string) string {
 	return ""
 }
 
+func enumValueSuggestion(value string) string {
+	if suggestion := suggestCorrectEnumValue(value); suggestion != "" {
+		return "Did you mean '" + suggestion + "'?"
+	}
+
+	return "Did you mean '" + removeSuffixDigits(value) + "'? Enum values typically don't contain numbers."
+}
+
 func removeAllDigits(value string) string {
 	var result strings.Builder
 
@@ -162,23 +238,52 @@ func removeAllDigits(value string) string {
 	return result.String()
 }
 
-func findFieldDefinitionLine(schemaContent string, fieldName string, typeName string) int {
-	lines := strings.Split(schemaContent, "\n")
-	for index, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
-
-		if strings.Contains(trimmedLine, fieldName+":") {
-			if strings.Contains(trimmedLine, typeName+"!") ||
-				strings.Contains(trimmedLine, typeName+"]") ||
-				strings.Contains(trimmedLine, "["+typeName) ||
-				strings.HasSuffix(trimmedLine, typeName) ||
-				strings.Contains(trimmedLine, typeName+" ") {
-				return index + 1
-			}
+// objectTypes returns the object type definitions followed by the object type
+// extensions, so that the rules about fields also check the fields that an
+// `extend type` adds.
+func objectTypes(doc *ast.Document) []ast.ObjectTypeDefinition {
+	objects := make([]ast.ObjectTypeDefinition, 0, len(doc.ObjectTypeDefinitions)+len(doc.ObjectTypeExtensions))
+	objects = append(objects, doc.ObjectTypeDefinitions...)
+
+	for _, extension := range doc.ObjectTypeExtensions {
+		objects = append(objects, extension.ObjectTypeDefinition)
+	}
+
+	return objects
+}
+
+// FieldParents maps field definition refs to the name of the object type or
+// interface that declares or extends them.
+func FieldParents(doc *ast.Document) map[int]string {
+	parents := make(map[int]string, len(doc.FieldDefinitions))
+
+	for _, obj := range objectTypes(doc) {
+		for _, fieldRef := range obj.FieldsDefinition.Refs {
+			parents[fieldRef] = doc.Input.ByteSliceString(obj.Name)
 		}
 	}
 
-	return 0
+	for _, iface := range doc.InterfaceTypeDefinitions {
+		for _, fieldRef := range iface.FieldsDefinition.Refs {
+			parents[fieldRef] = doc.Input.ByteSliceString(iface.Name)
+		}
+	}
+
+	return parents
+}
+
+// FieldCoordinate returns the schema coordinate of a field, such as
+// User.email, given the parents that FieldParents returns.
+func FieldCoordinate(parents map[int]string, fieldRef int, fieldName string) string {
+	if parent, ok := parents[fieldRef]; ok {
+		return parent + "." + fieldName
+	}
+
+	return fieldName
+}
+
+func argumentCoordinate(typeName, fieldName, argName string) string {
+	return typeName + "." + fieldName + "(" + argName + ":)"
 }
 
 func getBaseTypeName(doc *ast.Document, typeRef ast.Type) string {
@@ -236,6 +341,38 @@ func collectDefinedTypeNames(doc *ast.Document) map[string]bool {
 	return definedTypes
 }
 
+// typeNameRefs maps the name of every type defined in the document to the
+// reference of that name in the source.
+func typeNameRefs(doc *ast.Document) map[string]ast.ByteSliceReference {
+	refs := make(map[string]ast.ByteSliceReference)
+
+	for _, obj := range doc.ObjectTypeDefinitions {
+		refs[doc.Input.ByteSliceString(obj.Name)] = obj.Name
+	}
+
+	for _, input := range doc.InputObjectTypeDefinitions {
+		refs[doc.Input.ByteSliceString(input.Name)] = input.Name
+	}
+
+	for _, enum := range doc.EnumTypeDefinitions {
+		refs[doc.Input.ByteSliceString(enum.Name)] = enum.Name
+	}
+
+	for _, iface := range doc.InterfaceTypeDefinitions {
+		refs[doc.Input.ByteSliceString(iface.Name)] = iface.Name
+	}
+
+	for _, union := range doc.UnionTypeDefinitions {
+		refs[doc.Input.ByteSliceString(union.Name)] = union.Name
+	}
+
+	for _, scalar := range doc.ScalarTypeDefinitions {
+		refs[doc.Input.ByteSliceString(scalar.Name)] = scalar.Name
+	}
+
+	return refs
+}
+
 func markUsedTypes(doc *ast.Document, definedTypes map[string]bool) {
 	for _, fieldDef := range doc.FieldDefinitions {
 		baseType := getBaseTypeName(doc, doc.Types[fieldDef.Type])
@@ -286,25 +423,6 @@ func isAlphaUnderOrDigit(r rune) bool {
 	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
 }
 
-func findTypeLineNumber(typeName, schemaString string) int {
-	searchTerms := []string{
-		"type " + typeName,
-		"input " + typeName,
-		"enum " + typeName,
-		"interface " + typeName,
-		"union " + typeName,
-		"scalar " + typeName,
-	}
-
-	for _, term := range searchTerms {
-		if lineNum := findLineNumberByText(schemaString, term); lineNum > 0 {
-			return lineNum
-		}
-	}
-
-	return 0
-}
-
 func hasSuspiciousEnumValue(value string) bool {
 	if len(value) == 0 {
 		return false
@@ -339,13 +457,13 @@ func removeSuffixDigits(value string) string {
 	return result
 }
 
-func indexSlice(n int) []int {
-	indices := make([]int, n)
-	for i := range indices {
-		indices[i] = i
-	}
-
-	return indices
+// builtInScalars are the scalar types that every GraphQL schema has.
+var builtInScalars = map[string]bool{
+	"Boolean": true,
+	"Float":   true,
+	"ID":      true,
+	"Int":     true,
+	"String":  true,
 }
 
 func getAvailableTypes(builtInScalars, definedTypes map[string]bool) []string {
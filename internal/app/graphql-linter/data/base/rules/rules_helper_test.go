@@ -20,30 +20,58 @@ type BoolTestCase struct {
 	Expected bool
 }
 
-type LineTestCase struct {
-	Name          string
-	SchemaContent string
-	SearchText    string
-	WantLine      int
-}
-
-func TestGetLineContent(t *testing.T) {
+func TestLineIndex_Content(t *testing.T) {
 	t.Parallel()
 
-	schema := "A\nB\nC"
-	if GetLineContent(schema, 2) != "B" {
+	lines := NewLineIndex([]byte("A\n  B  \r\nC"))
+	if lines.Content(2) != "B" {
 		t.Errorf("expected B for line 2")
 	}
 
-	if GetLineContent(schema, 0) != "" {
-		t.Errorf("expected empty for line 0")
+	if lines.Content(3) != "C" {
+		t.Errorf("expected C for the last line")
+	}
+
+	if lines.Content(0) != "" || lines.Content(4) != "" {
+		t.Errorf("expected empty for lines outside the source")
 	}
 
-	if GetLineContent("", 1) != "" {
+	if NewLineIndex(nil).Content(1) != "" {
 		t.Errorf("expected empty for empty lines")
 	}
 }
 
+func TestLineIndex_Position(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		offset     uint32
+		wantLine   int
+		wantColumn int
+	}{
+		{"start of input", "type Query {\n  id: ID\n}", 0, 1, 1},
+		{"first line", "type Query {\n  id: ID\n}", 5, 1, 6},
+		{"after newline", "type Query {\n  id: ID\n}", 15, 2, 3},
+		{"end of input", "type Query {\n  id: ID\n}", 23, 3, 2},
+		{"beyond end of input", "a\nb", 10, 2, 2},
+		{"empty input", "", 0, 1, 1},
+		{"multi-byte characters", "\"é\" a", 4, 1, 4},
+		{"line after multi-byte characters", "# ü\nab", 7, 2, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			line, column := NewLineIndex([]byte(test.input)).position(test.offset)
+			if line != test.wantLine || column != test.wantColumn {
+				t.Errorf("got %d:%d, want %d:%d", line, column, test.wantLine, test.wantColumn)
+			}
+		})
+	}
+}
+
 func runBaseTypeTableTest(t *testing.T, tests []BaseTypeTestCase) {
 	t.Helper()
 
@@ -69,21 +97,6 @@ func setupDocumentWithInput(input string, types []ast.Type) *ast.Document {
 	return doc
 }
 
-func runLineTableTest(t *testing.T, tests []LineTestCase, testFunc func(string, string) int) {
-	t.Helper()
-
-	for _, test := range tests {
-		t.Run(test.Name, func(t *testing.T) {
-			t.Parallel()
-
-			got := testFunc(test.SchemaContent, test.SearchText)
-			if got != test.WantLine {
-				t.Errorf("got %v, want %v", got, test.WantLine)
-			}
-		})
-	}
-}
-
 func runBoolTableTest(t *testing.T, tests []BoolTestCase, testFunc func(string) bool) {
 	t.Helper()
 
@@ -123,3 +136,27 @@ func TestIsCamelCase(t *testing.T) {
 		}
 	}
 }
+
+func TestCamelCaseSuggestion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"user_name", "Rename it to 'userName'."},
+		{"FooBar", "Rename it to 'fooBar'."},
+		{"_private_value", "Rename it to 'privateValue'."},
+		{"alreadyCamel", ""},
+		{"___", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := camelCaseSuggestion(test.name); got != test.expected {
+				t.Errorf("camelCaseSuggestion(%q) = %q, want %q", test.name, got, test.expected)
+			}
+		})
+	}
+}
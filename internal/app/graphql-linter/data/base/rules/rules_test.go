@@ -5,93 +5,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
 	"github.com/stretchr/testify/assert"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
 )
 
-func TestFindLineNumberByText_ExtraCases(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name          string
-		schemaContent string
-		searchText    string
-		wantLine      int
-	}{
-		{"case sensitive", "Foo\nfoo\nFOO", "FOO", 3},
-		{
-			"three foo matches",
-			"foo\nfoo\nfoo", //nolint:dupword //multiple duplicates required to test whether it finds the first match
-			"foo",
-			1,
-		},
-		{"empty searchText", "foo\nbar", "", 1},
-		{"no match", "foo\nbar", "baz", 0},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			got := findLineNumberByText(test.schemaContent, test.searchText)
-			if got != test.wantLine {
-				t.Errorf("got %v, want %v", got, test.wantLine)
-			}
-		})
-	}
-}
-
-func TestFindLineNumberByText(t *testing.T) {
-	t.Parallel()
-
-	tests := []LineTestCase{
-		{
-			Name:          "text on first line",
-			SchemaContent: "enum Color {\nRED\nGREEN\n}",
-			SearchText:    "enum",
-			WantLine:      1,
-		},
-		{
-			Name:          "text on second line",
-			SchemaContent: "enum Color {\nRED\nGREEN\n}",
-			SearchText:    "RED",
-			WantLine:      2,
-		},
-		{
-			Name:          "text on third line",
-			SchemaContent: "enum Color {\nRED\nGREEN\n}",
-			SearchText:    "GREEN",
-			WantLine:      3,
-		},
-		{
-			Name:          "text not found",
-			SchemaContent: "enum Color {\nRED\nGREEN\n}",
-			SearchText:    "BLUE",
-			WantLine:      0,
-		},
-		{
-			Name:          "multiple matches, returns first",
-			SchemaContent: "A\nB\nA\nC",
-			SearchText:    "A",
-			WantLine:      1,
-		},
-		{
-			Name:          "empty schemaContent",
-			SchemaContent: "",
-			SearchText:    "anything",
-			WantLine:      0,
-		},
-		{
-			Name:          "empty searchText matches first line",
-			SchemaContent: "foo\nbar",
-			SearchText:    "",
-			WantLine:      1,
-		},
-	}
-
-	runLineTableTest(t, tests, findLineNumberByText)
-}
-
 func TestGetBaseTypeName(t *testing.T) {
 	t.Parallel()
 
@@ -560,55 +480,55 @@ func TestRemoveAllDigits_ExtraCases(t *testing.T) {
 func TestReportUncapitalizedDescription(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
-		name      string
-		kind      string
-		parent    string
-		field     string
-		desc      string
-		schema    string
-		expectNil bool
-		expectMsg string
+		name       string
+		kind       string
+		coordinate string
+		field      string
+		desc       string
+		schema     string
+		expectNil  bool
+		expectMsg  string
 	}{
 		{
-			"type capitalized", "type", "", "Query", "A capitalized description.", "type Query { id: ID }",
+			"type capitalized", "type", "Query", "Query", "A capitalized description.", "type Query { id: ID }",
 			true,
 			"",
 		},
 		{
-			"type uncapitalized", "type", "", "Query", "uncapitalized description.", "type Query { id: ID }",
+			"type uncapitalized", "type", "Query", "Query", "uncapitalized description.", "type Query { id: ID }",
 			false,
 			"should be capitalized",
 		},
 		{
-			"field capitalized", "field", "Query", "id", "ID field.", "type Query { id: ID }",
+			"field capitalized", "field", "Query.id", "id", "ID field.", "type Query { id: ID }",
 			true,
 			"",
 		},
 		{
-			"field uncapitalized", "field", "Query", "id", "id field.", "type Query { id: ID }",
+			"field uncapitalized", "field", "Query.id", "id", "id field.", "type Query { id: ID }",
 			false,
 			"should be capitalized",
 		},
 		{
-			"enum capitalized", "enum", "Status", "ACTIVE", "Active status.", "enum Status { ACTIVE }",
+			"enum capitalized", "enum", "Status.ACTIVE", "ACTIVE", "Active status.", "enum Status { ACTIVE }",
 			true,
 			"",
 		},
 		{
-			"enum uncapitalized", "enum", "Status", "ACTIVE", "active status.", "enum Status { ACTIVE }",
+			"enum uncapitalized", "enum", "Status.ACTIVE", "ACTIVE", "active status.", "enum Status { ACTIVE }",
 			false,
 			"should be capitalized",
 		},
 		{
-			"argument capitalized", "argument", "id", "input", "Input argument.", "type Query { id(input: String): ID }",
+			"argument capitalized", "argument", "Query.id(input:)", "input", "Input argument.",
+			"type Query { id(input: String): ID }",
 			true,
 			"",
 		},
 		{
-			"argument uncapitalized", "argument", "id", "input", "input argument.", "type Query { id(input: String): ID }",
+			"argument uncapitalized", "argument", "Query.id(input:)", "input", "input argument.",
+			"type Query { id(input: String): ID }",
 			false,
 			"should be capitalized",
 		},
@@ -617,13 +537,15 @@ func TestReportUncapitalizedDescription(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			err := rule.ReportUncapitalizedDescription(
-				test.kind,
-				test.parent,
-				test.field,
-				test.desc,
-				test.schema,
-			)
+			doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
+			lines := NewLineIndex(doc.Input.RawBytes)
+			start := strings.Index(test.schema, test.field)
+			nameRef := ast.ByteSliceReference{
+				Start: uint32(start),                   //nolint:gosec //test schemas are tiny
+				End:   uint32(start + len(test.field)), //nolint:gosec //test schemas are tiny
+			}
+
+			err := ReportUncapitalizedDescription(lines, test.kind, test.coordinate, nameRef, test.desc)
 			if test.expectNil {
 				if err != nil {
 					t.Errorf("expected nil, got %v", err)
@@ -631,18 +553,36 @@ func TestReportUncapitalizedDescription(t *testing.T) {
 			} else {
 				if err == nil {
 					t.Errorf("expected error, got nil")
-				} else if !strings.Contains(err.Message, test.expectMsg) {
-					t.Errorf("expected message to contain '%s', got '%s'", test.expectMsg, err.Message)
+				} else if !strings.Contains(err.String(), test.expectMsg) {
+					t.Errorf("expected message to contain '%s', got '%s'", test.expectMsg, err.String())
+				} else if err.Coordinate != test.coordinate {
+					t.Errorf("expected coordinate '%s', got '%s'", test.coordinate, err.Coordinate)
 				}
 			}
 		})
 	}
 }
 
-func TestFindMissingArgumentDescriptions(t *testing.T) {
+func TestUncapitalizedDescriptions_Coordinates(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
+	schema := `"""Query root."""
+type Query {
+  """the user."""
+  user("""the identifier.""" id: ID): String
+}`
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+
+	var got []string
+	for _, finding := range UncapitalizedDescriptions(&doc, NewLineIndex(doc.Input.RawBytes)) {
+		got = append(got, finding.Coordinate)
+	}
+
+	assert.Equal(t, []string{"Query.user", "Query.user(id:)"}, got)
+}
+
+func TestFindMissingArgumentDescriptions(t *testing.T) {
+	t.Parallel()
 
 	tests := []struct {
 		name        string
@@ -679,7 +619,7 @@ func TestFindMissingArgumentDescriptions(t *testing.T) {
 	for _, test := range tests {
 		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-		errs := rule.MissingArgumentDescriptions(&doc, test.schema)
+		errs := MissingArgumentDescriptions(&doc, NewLineIndex(doc.Input.RawBytes))
 		if test.expectError {
 			if len(errs) == 0 {
 				t.Errorf("%s: expected error, got none", test.name)
@@ -691,7 +631,7 @@ func TestFindMissingArgumentDescriptions(t *testing.T) {
 
 			for _, err := range errs {
 				if test.expectMsg == "" ||
-					(err.Message != "" && strings.Contains(err.Message, test.expectMsg)) {
+					(err.String() != "" && strings.Contains(err.String(), test.expectMsg)) {
 					found = true
 
 					break
@@ -715,8 +655,6 @@ func TestFindMissingArgumentDescriptions(t *testing.T) {
 func TestFindRelayConnectionTypesSpec(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
 		name       string
 		schema     string
@@ -755,7 +693,7 @@ func TestFindRelayConnectionTypesSpec(t *testing.T) {
 	for _, test := range tests {
 		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-		errs := rule.RelayConnectionTypesSpec(&doc, test.schema)
+		errs := RelayConnectionTypesSpec(&doc, NewLineIndex(doc.Input.RawBytes))
 		if len(test.expectMsgs) == 0 {
 			if len(errs) != 0 {
 				t.Errorf("%s: expected no errors, got %v", test.name, errs)
@@ -772,7 +710,7 @@ func TestFindRelayConnectionTypesSpec(t *testing.T) {
 			found := false
 
 			for _, err := range errs {
-				if strings.Contains(err.Message, expectMsg) {
+				if strings.Contains(err.String(), expectMsg) {
 					found = true
 
 					break
@@ -794,8 +732,6 @@ func TestFindRelayConnectionTypesSpec(t *testing.T) {
 func TestFindMissingInputObjectValueDescriptions(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
 		name         string
 		schema       string
@@ -827,7 +763,7 @@ func TestFindMissingInputObjectValueDescriptions(t *testing.T) {
 
 			doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-			errs := rule.MissingInputObjectValueDescriptions(&doc, test.schema)
+			errs := MissingInputObjectValueDescriptions(&doc, NewLineIndex(doc.Input.RawBytes))
 			if len(errs) != test.wantCount {
 				t.Errorf("got %d errors, want %d", len(errs), test.wantCount)
 			}
@@ -836,7 +772,7 @@ func TestFindMissingInputObjectValueDescriptions(t *testing.T) {
 				found := false
 
 				for _, err := range errs {
-					if strings.Contains(err.Message, substr) {
+					if strings.Contains(err.String(), substr) {
 						found = true
 
 						break
@@ -854,8 +790,6 @@ func TestFindMissingInputObjectValueDescriptions(t *testing.T) {
 func TestFindMissingEnumValueDescriptions(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
 		name        string
 		schema      string
@@ -883,7 +817,7 @@ func TestFindMissingEnumValueDescriptions(t *testing.T) {
 	for _, test := range tests {
 		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-		errs := rule.MissingEnumValueDescriptions(&doc, test.schema)
+		errs := MissingEnumValueDescriptions(&doc, NewLineIndex(doc.Input.RawBytes))
 		if test.expectError {
 			if len(errs) == 0 {
 				t.Errorf("%s: expected error, got none", test.name)
@@ -895,7 +829,7 @@ func TestFindMissingEnumValueDescriptions(t *testing.T) {
 
 			for _, err := range errs {
 				if test.expectMsg == "" ||
-					(err.Message != "" && strings.Contains(err.Message, test.expectMsg)) {
+					(err.String() != "" && strings.Contains(err.String(), test.expectMsg)) {
 					found = true
 
 					break
@@ -919,8 +853,6 @@ func TestFindMissingEnumValueDescriptions(t *testing.T) {
 func TestFindInputObjectValuesCamelCased(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
 		name        string
 		schema      string
@@ -954,7 +886,7 @@ func TestFindInputObjectValuesCamelCased(t *testing.T) {
 	for _, test := range tests {
 		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-		errs := rule.InputObjectValuesCamelCased(&doc, test.schema)
+		errs := InputObjectValuesCamelCased(&doc, NewLineIndex(doc.Input.RawBytes))
 		if test.expectError {
 			if len(errs) == 0 {
 				t.Errorf("%s: expected error, got none", test.name)
@@ -966,7 +898,7 @@ func TestFindInputObjectValuesCamelCased(t *testing.T) {
 
 			for _, err := range errs {
 				if test.expectMsg == "" ||
-					(err.Message != "" && strings.Contains(err.Message, test.expectMsg)) {
+					(err.String() != "" && strings.Contains(err.String(), test.expectMsg)) {
 					found = true
 
 					break
@@ -990,8 +922,6 @@ func TestFindInputObjectValuesCamelCased(t *testing.T) {
 func TestFindRelayPageInfoSpec(t *testing.T) {
 	t.Parallel()
 
-	rule := NewRule()
-
 	tests := []struct {
 		name        string
 		schema      string
@@ -1011,45 +941,251 @@ func TestFindRelayPageInfoSpec(t *testing.T) {
 	for _, test := range tests {
 		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
 
-		errs := rule.RelayPageInfoSpec(&doc, test.schema)
+		errs := RelayPageInfoSpec(&doc, NewLineIndex(doc.Input.RawBytes))
 		if test.expectError {
 			assert.NotEmpty(t, errs, test.name)
-			assert.Contains(t, errs[0].Message, "relay-page-info-spec")
+			assert.Contains(t, errs[0].String(), "relay-page-info-spec")
 		} else {
 			assert.Empty(t, errs, test.name)
 		}
 	}
 }
 
-func TestFindFieldDefinitionLine(t *testing.T) {
+func TestFindings_PointAtDefinition(t *testing.T) {
 	t.Parallel()
 
-	schema := "type Query { id: ID name: String }"
+	schema := "\"The user_name of the person.\"\ntype Query {\n  id: ID\n  user_name: String\n}"
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+	lines := NewLineIndex(doc.Input.RawBytes)
+
+	findings := FieldsAreCamelCased(&doc, lines)
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, 4, findings[0].Line)
+		assert.Equal(t, 3, findings[0].Column)
+		assert.Equal(t, 4, findings[0].EndLine)
+		assert.Equal(t, 12, findings[0].EndColumn)
+		assert.Equal(t, "user_name: String", findings[0].LineContent)
+	}
+
+	findings = MissingTypeDescriptions(&doc, lines)
+	assert.Empty(t, findings)
 
-	line := findFieldDefinitionLine(schema, "id", "test.graphql")
-	if line != 0 && line != 1 {
-		t.Errorf("expected line 0 or 1 for id, got %v", line)
+	findings = MissingFieldDescriptions(&doc, lines)
+	if assert.Len(t, findings, 2) {
+		assert.Equal(t, 3, findings[0].Line)
+		assert.Equal(t, 4, findings[1].Line)
 	}
+}
+
+func TestFindings_ExtendedTypes(t *testing.T) {
+	t.Parallel()
+
+	schema := "\"\"\"Users.\"\"\"\ntype Query {\n  \"\"\"All users.\"\"\"\n  users: ID\n}\n\n" +
+		"extend type Query {\n  user_name(id: ID): String\n  admin: ID\n}"
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+	lines := NewLineIndex(doc.Input.RawBytes)
+
+	coordinates := func(findings []models.Finding) []string {
+		var result []string
+		for _, finding := range findings {
+			result = append(result, finding.Coordinate)
+		}
 
-	if findFieldDefinitionLine(schema, "foo", "test.graphql") != 0 {
-		t.Errorf("expected 0 for missing field")
+		return result
 	}
+
+	assert.Equal(t, []string{"Query.user_name"}, coordinates(FieldsAreCamelCased(&doc, lines)))
+	assert.Equal(t, []string{"Query.user_name", "Query.admin"}, coordinates(MissingFieldDescriptions(&doc, lines)))
+	assert.Equal(t, []string{"Query.user_name(id:)"}, coordinates(MissingArgumentDescriptions(&doc, lines)))
+	assert.Equal(t, []string{"Query"}, coordinates(UnsortedTypeFields(&doc, lines)))
+	assert.Empty(t, MissingTypeDescriptions(&doc, lines))
 }
 
-func TestValidateEnumTypes(t *testing.T) {
+func TestUndefinedTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name:   "valid types",
+			schema: "enum Status { ACTIVE INACTIVE } type Query { id: ID name: String status: Status }",
+		},
+		{
+			name:   "undefined field type",
+			schema: "type Query { foo: [Bar!] }",
+			want:   []string{"Field 'Query.foo' references undefined type 'Bar'."},
+		},
+		{
+			name:   "undefined argument and input field types",
+			schema: "input FooInput { bar: Baz } type Query { foo(input: FooInpt): String }",
+			want: []string{
+				"Argument 'Query.foo(input:)' references undefined type 'FooInpt'.",
+				"Input field 'FooInput.bar' references undefined type 'Baz'.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
+
+			var messages []string
+			for _, finding := range UndefinedTypes(&doc, NewLineIndex(doc.Input.RawBytes)) {
+				assert.Equal(t, pkg_rules.RuleReferencedTypesAreDefined, finding.RuleID)
+				messages = append(messages, finding.Message)
+			}
+
+			assert.Equal(t, test.want, messages)
+		})
+	}
+}
+
+func TestUndefinedTypes_Suggestion(t *testing.T) {
 	t.Parallel()
 
-	r := NewRule()
+	schema := "type Query { user: Usr }\ntype User { id: ID }"
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+
+	findings := UndefinedTypes(&doc, NewLineIndex(doc.Input.RawBytes))
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, "Query.user", findings[0].Coordinate)
+		assert.Equal(t, "Did you mean 'User'?", findings[0].Suggestion)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	schema := "type Query {\n  id: ID\n  name String\n}\n"
+	_, parseReport := astparser.ParseGraphqlDocumentString(schema)
+
+	findings := ParseErrors(schema, &parseReport)
+	if assert.NotEmpty(t, findings) {
+		assert.Equal(t, pkg_rules.RuleInvalidGraphQLSchema, findings[0].RuleID)
+		assert.Equal(t, models.SeverityError, findings[0].Severity)
+		assert.Equal(t, 3, findings[0].Line)
+		assert.Positive(t, findings[0].Column)
+		assert.Equal(t, "name String", findings[0].LineContent)
+		assert.True(t, strings.HasPrefix(findings[0].Message, "Syntax error: "), findings[0].Message)
+	}
+
+	_, parseReport = astparser.ParseGraphqlDocumentString("type Query {\n  id: ID\n}\n")
+	assert.Empty(t, ParseErrors(schema, &parseReport))
+}
+
+func TestValidateEnumTypes(t *testing.T) {
+	t.Parallel()
 
 	doc, _ := astparser.ParseGraphqlDocumentString("enum Status { ACTIVE 1NVALID FOO1 }")
 
-	_, errorLines, _ := r.ValidateEnumTypes(
-		&doc,
-		nil,
-		"enum Status { ACTIVE 1NVALID FOO1 }",
-		"test.graphql",
-	)
+	_, errorLines, _ := ValidateEnumTypes(&doc, NewLineIndex(doc.Input.RawBytes))
 	if len(errorLines) == 0 {
 		t.Logf("validateEnumTypes returned no error lines for invalid enum types: %v", errorLines)
 	}
 }
+
+func TestFindings_HaveRuleIDAndCoordinate(t *testing.T) {
+	t.Parallel()
+
+	schema := "type Query {\n  user_name(id: ID): String\n}\n\nenum Status {\n  ACTIVE2\n}"
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+	lines := NewLineIndex(doc.Input.RawBytes)
+
+	tests := []struct {
+		name       string
+		findings   []models.Finding
+		ruleID     string
+		coordinate string
+		suggestion string
+	}{
+		{
+			name:       "field description",
+			findings:   MissingFieldDescriptions(&doc, lines),
+			ruleID:     "fields-have-descriptions",
+			coordinate: "Query.user_name",
+		},
+		{
+			name:       "argument description",
+			findings:   MissingArgumentDescriptions(&doc, lines),
+			ruleID:     "arguments-have-descriptions",
+			coordinate: "Query.user_name(id:)",
+		},
+		{
+			name:       "camel case",
+			findings:   FieldsAreCamelCased(&doc, lines),
+			ruleID:     "fields-are-camel-cased",
+			coordinate: "Query.user_name",
+			suggestion: "Rename it to 'userName'.",
+		},
+		{
+			name:       "enum value description",
+			findings:   MissingEnumValueDescriptions(&doc, lines),
+			ruleID:     "enum-values-have-descriptions",
+			coordinate: "Status.ACTIVE2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if assert.Len(t, test.findings, 1) {
+				finding := test.findings[0]
+				assert.Equal(t, test.ruleID, finding.RuleID)
+				assert.Equal(t, models.SeverityError, finding.Severity)
+				assert.Equal(t, test.coordinate, finding.Coordinate)
+				assert.Equal(t, test.suggestion, finding.Suggestion)
+				assert.NotContains(t, finding.Message, test.ruleID)
+			}
+		})
+	}
+
+	_, _, enumFindings := ValidateEnumTypes(&doc, lines)
+	if assert.Len(t, enumFindings, 1) {
+		assert.Equal(t, "suspicious-enum-value", enumFindings[0].RuleID)
+		assert.Equal(t, "Did you mean 'ACTIVE'? Enum values typically don't contain numbers.", enumFindings[0].Suggestion)
+	}
+}
+
+func TestFindUnsortedInterfaceFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		schema        string
+		expectError   bool
+		expectMessage string
+	}{
+		{
+			name:        "sorted interface fields",
+			schema:      `interface Foo { a: String b: Int }`,
+			expectError: false,
+		},
+		{
+			name:          "unsorted interface fields",
+			schema:        `interface Bar { z: String a: Int }`,
+			expectError:   true,
+			expectMessage: "interface-fields-sorted-alphabetically",
+		},
+		{
+			name:        "single field interface",
+			schema:      `interface Baz { a: String }`,
+			expectError: false,
+		},
+	}
+	for _, test := range tests {
+		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
+
+		errs := UnsortedInterfaceFields(&doc, NewLineIndex(doc.Input.RawBytes))
+		if test.expectError {
+			assert.NotEmpty(t, errs, test.name)
+			assert.Contains(t, errs[0].String(), test.expectMessage)
+		} else {
+			assert.Empty(t, errs, test.name)
+		}
+	}
+}
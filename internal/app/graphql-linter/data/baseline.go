@@ -0,0 +1,169 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+)
+
+const (
+	baselineFilePermissions = 0o644
+	baselineVersion         = 1
+)
+
+// Baseline records the findings that already existed when the linter was
+// adopted. Findings are keyed by file, rule and schema coordinate instead of
+// by line, so that a baseline keeps matching when definitions move around
+// within a file. File paths are relative to the directory of the baseline
+// file, so that a baseline matches from any working directory.
+type Baseline struct {
+	Version  int             `json:"version"`
+	Findings []BaselineEntry `json:"findings"`
+
+	// dir is the directory that the file paths are relative to.
+	dir string
+}
+
+// BaselineEntry is the number of findings of a rule on a schema coordinate.
+// Rules that do not report a coordinate, such as relay-page-info-spec, are
+// recorded once per file.
+type BaselineEntry struct {
+	File       string `json:"file"`
+	Rule       string `json:"rule"`
+	Coordinate string `json:"coordinate,omitempty"`
+	Count      int    `json:"count"`
+}
+
+type baselineKey struct {
+	file       string
+	rule       string
+	coordinate string
+}
+
+// NewBaseline records the given findings, with file paths relative to dir,
+// the directory of the baseline file.
+func NewBaseline(findings []models.Finding, dir string) Baseline {
+	counts := make(map[baselineKey]int)
+	for _, finding := range findings {
+		counts[newBaselineKey(finding, dir)]++
+	}
+
+	entries := make([]BaselineEntry, 0, len(counts))
+	for key, count := range counts {
+		entries = append(entries, BaselineEntry{
+			File:       key.file,
+			Rule:       key.rule,
+			Coordinate: key.coordinate,
+			Count:      count,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].File != entries[j].File {
+			return entries[i].File < entries[j].File
+		}
+
+		if entries[i].Rule != entries[j].Rule {
+			return entries[i].Rule < entries[j].Rule
+		}
+
+		return entries[i].Coordinate < entries[j].Coordinate
+	})
+
+	return Baseline{Version: baselineVersion, Findings: entries, dir: dir}
+}
+
+// ReadBaseline loads a baseline that was written by WriteBaseline.
+func ReadBaseline(path string) (Baseline, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return Baseline{}, fmt.Errorf("failed to read baseline file: %w", err)
+	}
+
+	var baseline Baseline
+
+	err = json.Unmarshal(data, &baseline)
+	if err != nil {
+		return Baseline{}, fmt.Errorf("failed to parse baseline file '%s': %w", path, err)
+	}
+
+	baseline.dir = filepath.Dir(path)
+
+	return baseline, nil
+}
+
+// WriteBaseline writes the baseline to path as indented JSON, so that changes
+// to it are easy to review.
+func WriteBaseline(path string, baseline Baseline) error {
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Clean(path), append(data, '\n'), baselineFilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to write baseline file: %w", err)
+	}
+
+	return nil
+}
+
+// Filter returns the findings that are not in the baseline, and the number of
+// findings that were. When a file, rule and coordinate occur more often than
+// recorded, the extra findings are new.
+func (b Baseline) Filter(findings []models.Finding) ([]models.Finding, int) {
+	remaining := make(map[baselineKey]int, len(b.Findings))
+	for _, entry := range b.Findings {
+		remaining[baselineKey{
+			file:       filepath.ToSlash(entry.File),
+			rule:       entry.Rule,
+			coordinate: entry.Coordinate,
+		}] += entry.Count
+	}
+
+	newFindings := make([]models.Finding, 0, len(findings))
+	known := 0
+
+	for _, finding := range findings {
+		if consumeBaselineEntry(remaining, finding, b.dir) {
+			known++
+
+			continue
+		}
+
+		newFindings = append(newFindings, finding)
+	}
+
+	return newFindings, known
+}
+
+// consumeBaselineEntry counts a finding against the entries that remain of
+// the baseline. Entries recorded under an identifier that the findings of the
+// rule were reported under before match too.
+func consumeBaselineEntry(remaining map[baselineKey]int, finding models.Finding, dir string) bool {
+	key := newBaselineKey(finding, dir)
+
+	for _, rule := range append([]string{finding.RuleID}, pkg_rules.PreviousRuleIDs(finding.RuleID)...) {
+		key.rule = rule
+		if remaining[key] > 0 {
+			remaining[key]--
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func newBaselineKey(finding models.Finding, dir string) baselineKey {
+	return baselineKey{
+		file:       RelativePath(dir, finding.FilePath),
+		rule:       finding.RuleID,
+		coordinate: finding.Coordinate,
+	}
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseline_FilterToleratesMovedCode(t *testing.T) {
+	t.Parallel()
+
+	baseline := NewBaseline([]models.Finding{
+		{FilePath: "user.graphqls", RuleID: "fields-have-descriptions", Coordinate: "User.name", Line: 4},
+		{FilePath: "user.graphqls", RuleID: "relay-page-info-spec", Line: 1},
+	}, ".")
+
+	findings := []models.Finding{
+		{FilePath: "user.graphqls", RuleID: "fields-have-descriptions", Coordinate: "User.name", Line: 12},
+		{FilePath: "user.graphqls", RuleID: "fields-have-descriptions", Coordinate: "User.email", Line: 13},
+		{FilePath: "user.graphqls", RuleID: "relay-page-info-spec", Line: 1},
+		{FilePath: "order.graphqls", RuleID: "relay-page-info-spec", Line: 1},
+	}
+
+	newFindings, known := baseline.Filter(findings)
+
+	assert.Equal(t, 2, known)
+	assert.Equal(t, []models.Finding{findings[1], findings[3]}, newFindings)
+}
+
+func TestBaseline_FilterCountsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	finding := models.Finding{FilePath: "a.graphqls", RuleID: "referenced-types-are-defined"}
+	baseline := NewBaseline([]models.Finding{finding}, ".")
+
+	newFindings, known := baseline.Filter([]models.Finding{finding, finding})
+
+	assert.Equal(t, 1, known)
+	assert.Equal(t, []models.Finding{finding}, newFindings)
+}
+
+func TestBaseline_FilterMatchesPreviousRuleIDs(t *testing.T) {
+	t.Parallel()
+
+	baseline := Baseline{Findings: []BaselineEntry{
+		{File: "a.graphqls", Rule: "invalid-graphql-schema", Coordinate: "Query", Count: 1},
+	}, dir: "."}
+	finding := models.Finding{FilePath: "a.graphqls", RuleID: "missing-query-root-type", Coordinate: "Query"}
+
+	newFindings, known := baseline.Filter([]models.Finding{finding})
+
+	assert.Equal(t, 1, known)
+	assert.Empty(t, newFindings)
+}
+
+func TestWriteAndReadBaseline(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	baseline := NewBaseline([]models.Finding{
+		{FilePath: "b.graphqls", RuleID: "types-have-descriptions", Coordinate: "B"},
+		{FilePath: "a.graphqls", RuleID: "types-have-descriptions", Coordinate: "A"},
+		{FilePath: "a.graphqls", RuleID: "types-have-descriptions", Coordinate: "A"},
+	}, ".")
+
+	require.NoError(t, WriteBaseline(path, baseline))
+
+	got, err := ReadBaseline(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Version)
+	assert.Equal(t, []BaselineEntry{
+		{File: "a.graphqls", Rule: "types-have-descriptions", Coordinate: "A", Count: 2},
+		{File: "b.graphqls", Rule: "types-have-descriptions", Coordinate: "B", Count: 1},
+	}, got.Findings)
+
+	_, err = ReadBaseline(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+// TestBaseline_RelativeToBaselineFile checks that a baseline records paths
+// relative to its own directory, so that it still matches when the linter runs
+// from another working directory.
+//
+//nolint:paralleltest //t.Chdir cannot run in parallel
+func TestBaseline_RelativeToBaselineFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "baseline.json")
+	finding := models.Finding{RuleID: "types-have-descriptions", Coordinate: "User"}
+
+	written := finding
+	written.FilePath = filepath.Join(dir, "schema", "user.graphqls")
+	require.NoError(t, WriteBaseline(path, NewBaseline([]models.Finding{written}, dir)))
+
+	baseline, err := ReadBaseline(path)
+	require.NoError(t, err)
+	assert.Equal(t, "schema/user.graphqls", baseline.Findings[0].File)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "schema"), 0o755))
+	t.Chdir(filepath.Join(dir, "schema"))
+
+	baseline, err = ReadBaseline(filepath.Join("..", "baseline.json"))
+	require.NoError(t, err)
+
+	reported := finding
+	reported.FilePath = "user.graphqls"
+
+	newFindings, known := baseline.Filter([]models.Finding{reported})
+	assert.Equal(t, 1, known)
+	assert.Empty(t, newFindings)
+}
@@ -0,0 +1,208 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/mcvs-golang-project-root/pkg/projectroot"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+const ConfigFileName = ".graphql-linter.yml"
+
+var errConfigExtendsCycle = errors.New("configuration extends itself")
+
+// ConfigLoader finds the configuration that applies to a schema file. An
+// explicit configuration path applies to every file. Otherwise the nearest
+// .graphql-linter.yml in the directory of the file or one of its parents, up
+// to the project root, applies. Every configuration file is loaded only once.
+type ConfigLoader struct {
+	configPath  string
+	projectRoot string
+	configs     map[string]*models.LinterConfig
+	nearest     map[string]string
+}
+
+func NewConfigLoader(configPath string) (*ConfigLoader, error) {
+	loader := &ConfigLoader{
+		configPath: configPath,
+		configs:    make(map[string]*models.LinterConfig),
+		nearest:    make(map[string]string),
+	}
+
+	if configPath != "" {
+		return loader, nil
+	}
+
+	projectRoot, err := projectroot.FindProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine project root: %w", err)
+	}
+
+	loader.projectRoot = projectRoot
+
+	return loader, nil
+}
+
+// Root returns the configuration that applies to the project as a whole: the
+// explicit configuration, the one in the project root, or the defaults.
+func (l *ConfigLoader) Root() (*models.LinterConfig, error) {
+	if l.configPath != "" {
+		_, statErr := os.Stat(l.configPath)
+		if os.IsNotExist(statErr) {
+			return nil, fmt.Errorf("config file does not exist at path: %s", l.configPath)
+		}
+
+		return l.load(l.configPath, nil)
+	}
+
+	log.Debug("No config path provided, using default project root search")
+
+	defaultConfigPath := filepath.Join(l.projectRoot, ConfigFileName)
+
+	_, statErr := os.Stat(defaultConfigPath)
+	if statErr != nil {
+		return l.defaults(), nil
+	}
+
+	return l.load(defaultConfigPath, nil)
+}
+
+// ForFile returns the configuration that applies to a schema file.
+func (l *ConfigLoader) ForFile(schemaFile string) (*models.LinterConfig, error) {
+	if l.configPath != "" {
+		return l.Root()
+	}
+
+	configPath := l.nearestConfig(filepath.Dir(schemaFile))
+	if configPath == "" {
+		return l.Root()
+	}
+
+	return l.load(configPath, nil)
+}
+
+// Configs returns every configuration that has been loaded so far, including
+// the ones that are only extended, ordered by path.
+func (l *ConfigLoader) Configs() []*models.LinterConfig {
+	paths := make([]string, 0, len(l.configs))
+	for path := range l.configs {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	configs := make([]*models.LinterConfig, 0, len(paths))
+	for _, path := range paths {
+		configs = append(configs, l.configs[path])
+	}
+
+	return configs
+}
+
+func (l *ConfigLoader) defaults() *models.LinterConfig {
+	config, ok := l.configs[""]
+	if !ok {
+		config = models.NewLinterConfig()
+		l.configs[""] = config
+	}
+
+	return config
+}
+
+// nearestConfig returns the path of the configuration file in dir or the
+// closest of its parents, without leaving the project root, or an empty string
+// if there is none.
+func (l *ConfigLoader) nearestConfig(dir string) string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+
+	if configPath, ok := l.nearest[absDir]; ok {
+		return configPath
+	}
+
+	configPath := ""
+
+	candidate := filepath.Join(absDir, ConfigFileName)
+	if _, statErr := os.Stat(candidate); statErr == nil {
+		configPath = candidate
+	} else if parent := filepath.Dir(absDir); parent != absDir && absDir != l.projectRoot {
+		configPath = l.nearestConfig(parent)
+	}
+
+	l.nearest[absDir] = configPath
+
+	return configPath
+}
+
+// load reads a configuration file and the configurations it extends. chain
+// holds the files that are being extended, to detect cycles.
+func (l *ConfigLoader) load(configPath string, chain []string) (*models.LinterConfig, error) {
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve config path: %w", err)
+	}
+
+	if config, ok := l.configs[absPath]; ok {
+		return config, nil
+	}
+
+	if slices.Contains(chain, absPath) {
+		return nil, fmt.Errorf(
+			"%w: %s",
+			errConfigExtendsCycle,
+			strings.Join(append(chain, absPath), " -> "),
+		)
+	}
+
+	data, err := os.ReadFile(filepath.Clean(configPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	err = validateConfigDocument(configPath, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file '%s': %w", configPath, err)
+	}
+
+	var header struct {
+		Extends string `yaml:"extends"`
+	}
+
+	err = yaml.Unmarshal(data, &header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	base := models.NewLinterConfig()
+
+	if header.Extends != "" {
+		basePath := header.Extends
+		if !filepath.IsAbs(basePath) {
+			basePath = filepath.Join(filepath.Dir(configPath), basePath)
+		}
+
+		base, err = l.load(basePath, append(chain, absPath))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load '%s' extended by '%s': %w", header.Extends, configPath, err)
+		}
+	}
+
+	config, err := base.Extend(configPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	l.configs[absPath] = config
+
+	return config, nil
+}
@@ -0,0 +1,319 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	yamlTagBool      = "!!bool"
+	yamlTagInt       = "!!int"
+	yamlTagNull      = "!!null"
+	yamlTagTimestamp = "!!timestamp"
+	untilLayout      = time.DateOnly
+)
+
+// ConfigError is a problem in a configuration file, reported at the position
+// of the offending key or value so that editors can jump to it.
+type ConfigError struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e ConfigError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+}
+
+// configValidator checks a configuration file against the structure that
+// models.LinterConfig expects, before it is decoded. Decoding alone silently
+// ignores unknown keys, so a typo such as "suppresions" would disable every
+// suppression without a word.
+type configValidator struct {
+	path   string
+	errors []error
+}
+
+// validateConfigDocument reports every unknown key, value of the wrong type,
+// unknown rule identifier and invalid pattern in a configuration file.
+func validateConfigDocument(path string, data []byte) error {
+	var document yaml.Node
+
+	err := yaml.Unmarshal(data, &document)
+	if err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	if len(document.Content) == 0 {
+		return nil
+	}
+
+	validator := configValidator{path: path}
+	validator.mapping(document.Content[0], "the configuration", map[string]func(*yaml.Node){
+		"exclude":      validator.pathPatterns,
+		"extends":      validator.string,
+		"include":      validator.pathPatterns,
+		"rules":        validator.rules,
+		"settings":     validator.settings,
+		"suppressions": validator.suppressions,
+	})
+
+	return errors.Join(validator.errors...)
+}
+
+func (v *configValidator) report(node *yaml.Node, format string, args ...any) {
+	v.errors = append(v.errors, ConfigError{
+		Path:    v.path,
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// mapping checks that node is a mapping with only the given keys, and
+// validates the value of every key.
+func (v *configValidator) mapping(node *yaml.Node, name string, keys map[string]func(*yaml.Node)) {
+	if isNull(node) {
+		return
+	}
+
+	if node.Kind != yaml.MappingNode {
+		v.report(node, "%s must be a mapping", name)
+
+		return
+	}
+
+	known := make([]string, 0, len(keys))
+	for key := range keys {
+		known = append(known, key)
+	}
+
+	sort.Strings(known)
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		key, value := node.Content[index], node.Content[index+1]
+
+		validate, ok := keys[key.Value]
+		if !ok {
+			v.report(key, "unknown key '%s' in %s%s", key.Value, name, suggestion(key.Value, known))
+
+			continue
+		}
+
+		validate(value)
+	}
+}
+
+func (v *configValidator) settings(node *yaml.Node) {
+	v.mapping(node, "settings", map[string]func(*yaml.Node){
+		"checkDescriptions":        v.bool,
+		"reportUnusedSuppressions": v.bool,
+		"requireSuppressionReason": v.bool,
+		"respectGitignore":         v.bool,
+		"strictMode":               v.bool,
+		"validateFederation":       v.bool,
+	})
+}
+
+func (v *configValidator) rules(node *yaml.Node) {
+	if isNull(node) {
+		return
+	}
+
+	if node.Kind != yaml.MappingNode {
+		v.report(node, "rules must be a mapping of rule identifiers to levels")
+
+		return
+	}
+
+	levels := []string{string(models.RuleLevelOff), string(models.RuleLevelWarn), string(models.RuleLevelError)}
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		key, value := node.Content[index], node.Content[index+1]
+
+		v.ruleID(key)
+
+		if value.Kind != yaml.ScalarNode || !slices.Contains(levels, value.Value) {
+			v.report(
+				value,
+				"invalid rule level '%s' for %s, expected one of: %s",
+				value.Value,
+				key.Value,
+				strings.Join(levels, ", "),
+			)
+		}
+	}
+}
+
+func (v *configValidator) suppressions(node *yaml.Node) {
+	if isNull(node) {
+		return
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		v.report(node, "suppressions must be a list")
+
+		return
+	}
+
+	for index, suppression := range node.Content {
+		v.mapping(suppression, fmt.Sprintf("suppression %d", index), map[string]func(*yaml.Node){
+			"file":   v.filePattern,
+			"line":   v.int,
+			"owner":  v.string,
+			"reason": v.string,
+			"rule":   v.ruleList,
+			"until":  v.date,
+			"value":  v.valuePattern,
+		})
+	}
+}
+
+// ruleList accepts a single rule identifier or a list of them.
+func (v *configValidator) ruleList(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if !isNull(node) {
+			v.ruleID(node)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				v.report(item, "rule must be a rule identifier")
+
+				continue
+			}
+
+			v.ruleID(item)
+		}
+	case yaml.DocumentNode, yaml.MappingNode, yaml.AliasNode:
+		v.report(node, "rule must be a rule identifier or a list of them")
+	}
+}
+
+func (v *configValidator) ruleID(node *yaml.Node) {
+	if _, ok := registry.Lookup(node.Value); ok {
+		return
+	}
+
+	rules := registry.Rules()
+
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	v.report(node, "unknown rule '%s'%s", node.Value, suggestion(node.Value, ruleIDs))
+}
+
+func (v *configValidator) pathPatterns(node *yaml.Node) {
+	if isNull(node) {
+		return
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		v.report(node, "expected a list of glob patterns")
+
+		return
+	}
+
+	for _, item := range node.Content {
+		if !v.scalar(item) {
+			continue
+		}
+
+		err := pkg_rules.ValidatePathPattern(item.Value)
+		if err != nil {
+			v.report(item, "%s", err)
+		}
+	}
+}
+
+func (v *configValidator) filePattern(node *yaml.Node) {
+	if !v.scalar(node) {
+		return
+	}
+
+	err := pkg_rules.ValidateSuppressionPatterns(models.Suppression{File: node.Value})
+	if err != nil {
+		v.report(node, "%s", err)
+	}
+}
+
+func (v *configValidator) valuePattern(node *yaml.Node) {
+	if !v.scalar(node) {
+		return
+	}
+
+	err := pkg_rules.ValidateSuppressionPatterns(models.Suppression{Value: node.Value})
+	if err != nil {
+		v.report(node, "%s", err)
+	}
+}
+
+// string accepts any scalar, because numbers and booleans decode into a
+// string unchanged.
+func (v *configValidator) string(node *yaml.Node) {
+	v.scalar(node)
+}
+
+func (v *configValidator) scalar(node *yaml.Node) bool {
+	if node.Kind != yaml.ScalarNode {
+		v.report(node, "expected a string")
+
+		return false
+	}
+
+	return true
+}
+
+func (v *configValidator) bool(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || (node.Tag != yamlTagBool && !isNull(node)) {
+		v.report(node, "expected true or false, got '%s'", node.Value)
+	}
+}
+
+func (v *configValidator) int(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || (node.Tag != yamlTagInt && !isNull(node)) {
+		v.report(node, "expected a whole number, got '%s'", node.Value)
+	}
+}
+
+func (v *configValidator) date(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode {
+		if node.Tag == yamlTagTimestamp || isNull(node) {
+			return
+		}
+
+		if _, err := time.Parse(untilLayout, node.Value); err == nil {
+			return
+		}
+	}
+
+	v.report(node, "expected a date such as 2026-12-31, got '%s'", node.Value)
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == yamlTagNull
+}
+
+// suggestion returns a hint with the candidate that is closest to value, or
+// an empty string if none of them is close enough to be a likely typo.
+func suggestion(value string, candidates []string) string {
+	closest := pkg_rules.ClosestMatch(value, candidates)
+	if closest == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", did you mean '%s'?", closest)
+}
@@ -0,0 +1,227 @@
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr []string
+	}{
+		{"empty", "", nil},
+		{
+			"valid",
+			`extends: ../base.yml
+include: ["**/*.graphqls", "**/*.gql"]
+exclude:
+  - "**/__generated__/**"
+settings:
+  strictMode: false
+  validateFederation: true
+rules:
+  relay-page-info-spec: off
+suppressions:
+  - file: "**/generated/*.graphqls"
+    line: 4
+    rule: [types-have-descriptions, fields-have-descriptions]
+    value: ^Legacy.*
+    reason: generated
+    until: 2026-12-31
+    owner: team-orders
+  - rule: suspicious-enum-value
+    until: "2026-12-31"
+`,
+			nil,
+		},
+		{
+			"unknown top-level key",
+			"suppresions:\n  - rule: types-have-descriptions\n",
+			[]string{"config.yml:1:1: unknown key 'suppresions' in the configuration, did you mean 'suppressions'?"},
+		},
+		{
+			"unknown setting",
+			"settings:\n  validateFederaton: false\n",
+			[]string{"config.yml:2:3: unknown key 'validateFederaton' in settings, did you mean 'validateFederation'?"},
+		},
+		{
+			"unknown key without a close match",
+			"colour: red\n",
+			[]string{"config.yml:1:1: unknown key 'colour' in the configuration"},
+		},
+		{
+			"wrong types",
+			"settings:\n  strictMode: yes please\nsuppressions:\n  - line: four\n    until: soon\n",
+			[]string{
+				"config.yml:2:15: expected true or false, got 'yes please'",
+				"config.yml:4:11: expected a whole number, got 'four'",
+				"config.yml:5:12: expected a date such as 2026-12-31, got 'soon'",
+			},
+		},
+		{
+			"sections of the wrong type",
+			"settings: true\nsuppressions:\n  rule: x\n",
+			[]string{
+				"config.yml:1:11: settings must be a mapping",
+				"config.yml:3:3: suppressions must be a list",
+			},
+		},
+		{
+			"unknown rules in suppressions",
+			"suppressions:\n  - rule: suspicious_enum_value\n  - rule: [types-have-descriptions, undefined_type]\n",
+			[]string{
+				"config.yml:2:11: unknown rule 'suspicious_enum_value', did you mean 'suspicious-enum-value'?",
+				"config.yml:3:37: unknown rule 'undefined_type'",
+			},
+		},
+		{
+			"unknown rule and level",
+			"rules:\n  no-such-rule: off\n  types-have-descriptions: warning\n",
+			[]string{
+				"config.yml:2:3: unknown rule 'no-such-rule'",
+				"config.yml:3:28: invalid rule level 'warning' for types-have-descriptions, expected one of: off, warn, error",
+			},
+		},
+		{
+			"discovery patterns",
+			"include: \"**/*.gql\"\nexclude:\n  - \"schema/[a\"\n",
+			[]string{
+				"config.yml:1:10: expected a list of glob patterns",
+				"config.yml:3:5: invalid path pattern 'schema/[a'",
+			},
+		},
+		{
+			"invalid patterns",
+			"suppressions:\n  - value: ^(Legacy\n",
+			[]string{"config.yml:2:12: invalid value pattern '^(Legacy'"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateConfigDocument("config.yml", []byte(test.config))
+			if len(test.wantErr) == 0 {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+
+			lines := strings.Split(err.Error(), "\n")
+			require.Len(t, lines, len(test.wantErr), err.Error())
+
+			for index, want := range test.wantErr {
+				assert.Contains(t, lines[index], want)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_ReportsPositionOfUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), ConfigFileName)
+	require.NoError(t, os.WriteFile(configPath, []byte("settings:\n  stritcMode: false\n"), 0o600))
+
+	loader, err := NewConfigLoader(configPath)
+	require.NoError(t, err)
+
+	_, err = loader.Root()
+
+	var configErr ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, ConfigError{
+		Path:    configPath,
+		Line:    2,
+		Column:  3,
+		Message: "unknown key 'stritcMode' in settings, did you mean 'strictMode'?",
+	}, configErr)
+}
+
+// TestConfigJSONSchema keeps the published JSON Schema in line with the
+// configuration the linter accepts.
+func TestConfigJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "assets", "graphql-linter.schema.json"))
+	require.NoError(t, err)
+
+	var schema struct {
+		Properties struct {
+			Settings struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"settings"`
+		} `json:"properties"`
+		Definitions struct {
+			RuleID struct {
+				Enum []string `json:"enum"`
+			} `json:"ruleId"`
+			Suppression struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"suppression"`
+		} `json:"definitions"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	var topLevel struct {
+		Properties map[string]any `json:"properties"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &topLevel))
+
+	ruleIDs := make([]string, 0, len(registry.Rules()))
+	for _, rule := range registry.Rules() {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	assert.Equal(t, ruleIDs, schema.Definitions.RuleID.Enum)
+	assert.Equal(t, yamlKeys(models.LinterConfig{}), sortedKeys(topLevel.Properties))
+	assert.Equal(t, yamlKeys(models.Settings{}), sortedKeys(schema.Properties.Settings.Properties))
+	assert.Equal(t, yamlKeys(models.Suppression{}), sortedKeys(schema.Definitions.Suppression.Properties))
+}
+
+func yamlKeys(value any) []string {
+	valueType := reflect.TypeOf(value)
+
+	keys := make([]string, 0, valueType.NumField())
+
+	for index := range valueType.NumField() {
+		key, _, _ := strings.Cut(valueType.Field(index).Tag.Get("yaml"), ",")
+		if key != "" && key != "-" {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedKeys(properties map[string]any) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
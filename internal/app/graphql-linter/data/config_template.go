@@ -0,0 +1,143 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	configFilePermissions = 0o644
+	configSchemaURL       = "https://raw.githubusercontent.com/schubergphilis/graphql-linter/" +
+		"main/assets/graphql-linter.schema.json"
+	yamlIndent = 2
+)
+
+var errConfigExists = errors.New("configuration file already exists")
+
+type templateSetting struct {
+	key         string
+	value       bool
+	description string
+}
+
+// NewConfigTemplate renders a configuration file that lists every setting
+// with its default and every rule at its default level, each with a comment
+// that explains it, followed by the given suppressions.
+func NewConfigTemplate(suppressions []models.Suppression) ([]byte, error) {
+	defaults := models.NewLinterConfig().Settings
+	settings := []templateSetting{
+		{
+			"strictMode",
+			defaults.StrictMode,
+			"Treat warnings as errors, so that findings of rules set to warn also fail the run.",
+		},
+		{
+			"validateFederation",
+			defaults.ValidateFederation,
+			"Validate the schema as an Apollo Federation subgraph.",
+		},
+		{
+			"checkDescriptions",
+			defaults.CheckDescriptions,
+			"Run the *-have-descriptions rules and descriptions-are-capitalized.",
+		},
+		{
+			"reportUnusedSuppressions",
+			defaults.ReportUnusedSuppressions,
+			"Report suppressions that match nothing as unused-suppression findings.",
+		},
+		{
+			"requireSuppressionReason",
+			defaults.RequireSuppressionReason,
+			"Report suppressions without a reason as missing-suppression-reason findings.",
+		},
+		{
+			"respectGitignore",
+			defaults.RespectGitignore,
+			"Skip the files that are ignored by .gitignore files.",
+		},
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("# yaml-language-server: $schema=" + configSchemaURL + "\n")
+	builder.WriteString("---\n")
+	builder.WriteString("# GraphQL Linter configuration, see\n")
+	builder.WriteString("# https://github.com/schubergphilis/graphql-linter#configuration\n\n")
+
+	builder.WriteString("# Schema files to lint, as glob patterns relative to the target path. The\n")
+	builder.WriteString("# default is **/*.graphql and **/*.graphqls.\n")
+	builder.WriteString("# include:\n#   - \"**/*.graphqls\"\n#   - \"**/*.gql\"\n\n")
+	builder.WriteString("# Files and directories to skip, in addition to node_modules, vendor and\n")
+	builder.WriteString("# dot-directories.\n")
+	builder.WriteString("# exclude:\n#   - \"**/__generated__/**\"\n\n")
+	builder.WriteString("settings:\n")
+
+	for _, setting := range settings {
+		fmt.Fprintf(&builder, "  # %s\n  %s: %t\n", setting.description, setting.key, setting.value)
+	}
+
+	builder.WriteString("\n# Per-rule level: off (do not run), warn (report without failing) or error.\n")
+	builder.WriteString("rules:\n")
+
+	for _, rule := range registry.Rules() {
+		fmt.Fprintf(&builder, "  # %s\n  %s: %s\n", rule.Description, rule.ID, registry.Level(nil, rule.ID))
+	}
+
+	builder.WriteString("\n# Findings to silence. file is a path suffix or glob pattern, value an exact\n")
+	builder.WriteString("# symbol, a glob pattern or a regular expression anchored with ^ or $.\n")
+
+	if len(suppressions) == 0 {
+		builder.WriteString("suppressions: []\n")
+
+		return []byte(builder.String()), nil
+	}
+
+	var encoded strings.Builder
+
+	encoder := yaml.NewEncoder(&encoded)
+	encoder.SetIndent(yamlIndent)
+
+	err := encoder.Encode(map[string][]models.Suppression{"suppressions": suppressions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode suppressions: %w", err)
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode suppressions: %w", err)
+	}
+
+	builder.WriteString(encoded.String())
+
+	return []byte(builder.String()), nil
+}
+
+// WriteConfigTemplate writes NewConfigTemplate to path. An existing file is
+// never overwritten, because it may hold suppressions that were written by
+// hand.
+func WriteConfigTemplate(path string, suppressions []models.Suppression) error {
+	_, err := os.Stat(path)
+	if err == nil {
+		return fmt.Errorf("%w: %s", errConfigExists, path)
+	}
+
+	content, err := NewConfigTemplate(suppressions)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filepath.Clean(path), content, configFilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
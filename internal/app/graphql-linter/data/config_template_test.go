@@ -0,0 +1,70 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfigTemplate(t *testing.T) {
+	t.Parallel()
+
+	suppressions := []models.Suppression{
+		{
+			File:   "schema/user.graphqls",
+			Rule:   models.RuleList{pkg_rules.RuleTypesHaveDescriptions},
+			Value:  "User",
+			Reason: "Existing finding",
+		},
+	}
+
+	content, err := NewConfigTemplate(suppressions)
+	require.NoError(t, err)
+	require.NoError(t, validateConfigDocument("template.yml", content))
+
+	config, err := models.NewLinterConfig().Extend("template.yml", content)
+	require.NoError(t, err)
+
+	assert.Equal(t, models.NewLinterConfig().Settings, config.Settings)
+	assert.Len(t, config.Rules, len(registry.Rules()))
+
+	for _, rule := range registry.Rules() {
+		assert.Equal(t, registry.Level(nil, rule.ID), config.Rules[rule.ID], rule.ID)
+		assert.Contains(t, string(content), "# "+rule.Description+"\n")
+	}
+
+	for _, key := range yamlKeys(models.Settings{}) {
+		assert.Contains(t, string(content), "  "+key+": ")
+	}
+
+	assert.Equal(t, suppressions, config.Suppressions)
+}
+
+func TestNewConfigTemplate_WithoutSuppressions(t *testing.T) {
+	t.Parallel()
+
+	content, err := NewConfigTemplate(nil)
+	require.NoError(t, err)
+	require.NoError(t, validateConfigDocument("template.yml", content))
+	assert.Contains(t, string(content), "\nsuppressions: []\n")
+}
+
+func TestWriteConfigTemplate_KeepsExistingFile(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), ConfigFileName)
+	require.NoError(t, os.WriteFile(configPath, []byte("settings: {}\n"), 0o600))
+
+	err := WriteConfigTemplate(configPath, nil)
+	require.ErrorIs(t, err, errConfigExists)
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "settings: {}\n", string(content))
+}
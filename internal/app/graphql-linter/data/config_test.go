@@ -0,0 +1,107 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfigLoader(t *testing.T, projectRoot string, files map[string]string) *ConfigLoader {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(projectRoot, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	return &ConfigLoader{
+		projectRoot: projectRoot,
+		configs:     make(map[string]*models.LinterConfig),
+		nearest:     make(map[string]string),
+	}
+}
+
+func TestConfigLoader_ForFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	loader := newTestConfigLoader(t, root, map[string]string{
+		".graphql-linter.yml": "rules:\n  types-have-descriptions: off\n" +
+			"suppressions:\n  - rule: relay-page-info-spec\n",
+		"shared/base.yml": "rules:\n  fields-have-descriptions: warn\n" +
+			"suppressions:\n  - rule: defined-types-are-used\n",
+		"accounts/.graphql-linter.yml": "extends: ../shared/base.yml\n" +
+			"settings:\n  strictMode: true\n" +
+			"suppressions:\n  - rule: fields-are-camel-cased\n",
+	})
+
+	accounts, err := loader.ForFile(filepath.Join(root, "accounts", "schema", "user.graphqls"))
+	require.NoError(t, err)
+	assert.True(t, accounts.Settings.StrictMode)
+	assert.True(t, accounts.Settings.ValidateFederation)
+	assert.Equal(t, map[string]models.RuleLevel{"fields-have-descriptions": models.RuleLevelWarn}, accounts.Rules)
+	assert.Len(t, accounts.Suppressions, 2)
+	assert.Equal(t, models.RuleList{"fields-are-camel-cased"}, accounts.DeclaredSuppressions()[0].Rule)
+
+	orders, err := loader.ForFile(filepath.Join(root, "orders", "order.graphqls"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, ConfigFileName), orders.Path)
+	assert.Equal(t, models.RuleLevelOff, orders.Rules["types-have-descriptions"])
+
+	assert.Len(t, loader.Configs(), 3)
+}
+
+func TestConfigLoader_InheritedSuppressionsShareTheirUse(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	loader := newTestConfigLoader(t, root, map[string]string{
+		"base.yml":                  "suppressions:\n  - rule: defined-types-are-used\n",
+		"a/.graphql-linter.yml":     "extends: ../base.yml\n",
+		"b/.graphql-linter.yml":     "extends: ../base.yml\n",
+		"b/sub/.graphql-linter.yml": "extends: ../.graphql-linter.yml\n",
+	})
+
+	configA, err := loader.ForFile(filepath.Join(root, "a", "a.graphqls"))
+	require.NoError(t, err)
+
+	configA.MarkSuppressionUsed(0)
+
+	base, err := loader.load(filepath.Join(root, "base.yml"), nil)
+	require.NoError(t, err)
+	assert.True(t, base.Suppressions[0].Used())
+
+	configB, err := loader.ForFile(filepath.Join(root, "b", "sub", "b.graphqls"))
+	require.NoError(t, err)
+	assert.True(t, configB.Suppressions[0].Used())
+	assert.Empty(t, configB.DeclaredSuppressions())
+}
+
+func TestConfigLoader_ExtendsCycle(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	loader := newTestConfigLoader(t, root, map[string]string{
+		".graphql-linter.yml": "extends: other.yml\n",
+		"other.yml":           "extends: .graphql-linter.yml\n",
+	})
+
+	_, err := loader.Root()
+	require.ErrorIs(t, err, errConfigExtendsCycle)
+}
+
+func TestConfigLoader_RootDefaults(t *testing.T) {
+	t.Parallel()
+
+	loader := newTestConfigLoader(t, t.TempDir(), nil)
+
+	config, err := loader.ForFile(filepath.Join(loader.projectRoot, "schema.graphqls"))
+	require.NoError(t, err)
+	assert.Equal(t, models.NewLinterConfig().Settings, config.Settings)
+	assert.Empty(t, config.Path)
+}
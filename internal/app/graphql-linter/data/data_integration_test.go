@@ -11,32 +11,32 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestStore_ReadAndValidateSchemaFile(t *testing.T) {
+func TestStore_ReadSchemaFile(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		content  string
-		file     string
-		exists   bool
-		wantStr  string
-		wantBool bool
+		name    string
+		content string
+		file    string
+		exists  bool
+		wantStr string
+		wantErr bool
 	}{
 		{
-			name:     "file exists",
-			content:  "type Query { id: ID }",
-			file:     "test_exists.graphql",
-			exists:   true,
-			wantStr:  "type Query { id: ID }",
-			wantBool: true,
+			name:    "file exists",
+			content: "type Query { id: ID }",
+			file:    "test_exists.graphql",
+			exists:  true,
+			wantStr: "type Query { id: ID }",
+			wantErr: false,
 		},
 		{
-			name:     "file does not exist",
-			content:  "",
-			file:     "test_missing.graphql",
-			exists:   false,
-			wantStr:  "",
-			wantBool: false,
+			name:    "file does not exist",
+			content: "",
+			file:    "test_missing.graphql",
+			exists:  false,
+			wantStr: "",
+			wantErr: true,
 		},
 	}
 
@@ -55,13 +55,13 @@ func TestStore_ReadAndValidateSchemaFile(t *testing.T) {
 				}
 			}
 
-			gotStr, gotBool := store.ReadAndValidateSchemaFile(path)
+			gotStr, err := store.ReadSchemaFile(path)
 			if gotStr != test.wantStr {
 				t.Errorf("gotStr = %q, want %q", gotStr, test.wantStr)
 			}
 
-			if gotBool != test.wantBool {
-				t.Errorf("gotBool = %v, want %v", gotBool, test.wantBool)
+			if (err != nil) != test.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, test.wantErr)
 			}
 		})
 	}
@@ -80,13 +80,13 @@ func TestIntegrationLoadConfig(t *testing.T) {
 		{
 			name:         "no config file",
 			configYAML:   "",
-			wantStrict:   true,
+			wantStrict:   false,
 			wantSuppress: 0,
 		},
 		{
 			name:         "with verbose logging",
 			configYAML:   "",
-			wantStrict:   true,
+			wantStrict:   false,
 			wantSuppress: 0,
 			wantVerbose:  true,
 		},
@@ -165,7 +165,7 @@ func TestLoadConfig(t *testing.T) {
 			removeFile:     true,
 			expectError:    false,
 			expectSuppress: 1,
-			configContent: "suppressions:\n  - rule: test-rule\n    file: test.graphql\n    line: 1\n" +
+			configContent: "suppressions:\n  - rule: types-have-descriptions\n    file: test.graphql\n    line: 1\n" +
 				"    value: test value\n    reason: test reason\n",
 		},
 		{
@@ -174,7 +174,7 @@ func TestLoadConfig(t *testing.T) {
 			removeFile:     true,
 			expectError:    false,
 			expectSuppress: 1,
-			configContent: "suppressions:\n  - rule: test-rule\n    file: test.graphql\n    line: 1\n" +
+			configContent: "suppressions:\n  - rule: types-have-descriptions\n    file: test.graphql\n    line: 1\n" +
 				"    value: test value\n    reason: test reason\n",
 		},
 		{
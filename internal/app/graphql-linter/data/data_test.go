@@ -2,14 +2,13 @@ package data
 
 import (
 	"os"
-	"strings"
+	"path/filepath"
 	"testing"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
-	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/federation"
 	"github.com/stretchr/testify/assert"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+	"github.com/stretchr/testify/require"
 )
 
 func TestReadSchemaFile(t *testing.T) {
@@ -20,37 +19,34 @@ func TestReadSchemaFile(t *testing.T) {
 	tempFile := createTempSchemaFile(t, content)
 	defer os.Remove(tempFile)
 
-	got, ok := readSchemaFile(tempFile)
-	if !ok || got != content {
+	got, err := readSchemaFile(tempFile)
+	if err != nil || got != content {
 		t.Errorf("got %v, want %v", got, content)
 	}
 }
 
-func TestFilterSchemaComments(t *testing.T) {
+func TestRelativePath(t *testing.T) {
 	t.Parallel()
 
-	schema := "// comment\ntype Query { id: ID }\n// another"
-	want := "type Query { id: ID }"
-
-	got := FilterSchemaComments(schema)
-	if !strings.Contains(got, want) {
-		t.Errorf("got %v, want %v", got, want)
-	}
+	assert.Equal(t, "schema/user.graphqls", RelativePath(".", "schema/user.graphqls"))
+	assert.Equal(t, "user.graphqls", RelativePath("schema", "schema/user.graphqls"))
+	assert.Equal(t, "other/user.graphqls", RelativePath("schema", "other/user.graphqls"))
+	assert.Empty(t, RelativePath("schema", ""))
 }
 
 func TestValidateFederationSchema(t *testing.T) {
 	t.Parallel()
 
-	got := federation.ValidateFederationSchema("type Query { id: ID }")
-	if !got {
-		t.Errorf("expected federation schema to be valid")
+	err := federation.ValidateFederationSchema("type Query { id: ID }")
+	if err != nil {
+		t.Errorf("expected federation schema to be valid, got: %v", err)
 	}
 }
 
 func TestNewStore(t *testing.T) {
 	t.Parallel()
 
-	store, err := NewStore("", "/tmp", rules.Rule{}, true)
+	store, err := NewStore("", "/tmp", true)
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -60,110 +56,27 @@ func TestNewStore(t *testing.T) {
 	}
 }
 
-func TestFindUnsortedInterfaceFields(t *testing.T) {
+func TestLoadConfig_SuppressionRuleList(t *testing.T) {
 	t.Parallel()
 
-	store, err := NewStore("", "/tmp", rules.Rule{}, true)
-	if err != nil {
-		t.Errorf("unexpected error: %v", err)
-	}
-
-	tests := []struct {
-		name          string
-		schema        string
-		expectError   bool
-		expectMessage string
-	}{
-		{
-			name:        "sorted interface fields",
-			schema:      `interface Foo { a: String b: Int }`,
-			expectError: false,
-		},
-		{
-			name:          "unsorted interface fields",
-			schema:        `interface Bar { z: String a: Int }`,
-			expectError:   true,
-			expectMessage: "interface-fields-sorted-alphabetically",
-		},
-		{
-			name:        "single field interface",
-			schema:      `interface Baz { a: String }`,
-			expectError: false,
-		},
-	}
-	for _, test := range tests {
-		doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
-
-		errs := store.UnsortedInterfaceFields(&doc, test.schema)
-		if test.expectError {
-			assert.NotEmpty(t, errs, test.name)
-			assert.Contains(t, errs[0].Message, test.expectMessage)
-		} else {
-			assert.Empty(t, errs, test.name)
-		}
-	}
-}
-
-func TestCollectUnsuppressedDataTypeErrors(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name         string
-		schema       string
-		config       *models.LinterConfig
-		wantCount    int
-		wantContains []string
-	}{
-		{
-			name:      "valid enum types",
-			schema:    `enum Color { RED GREEN BLUE } type Query { color: Color }`,
-			config:    &models.LinterConfig{},
-			wantCount: 0,
-		},
-		{
-			name:      "valid types without enum errors",
-			schema:    `type Query { foo: String }`,
-			config:    &models.LinterConfig{},
-			wantCount: 0,
-		},
-		{
-			name:      "schema with no enum description errors",
-			schema:    `enum Status { ACTIVE INACTIVE } type Query { status: Status }`,
-			config:    &models.LinterConfig{},
-			wantCount: 0,
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			store := Store{LinterConfig: test.config, Ruler: rules.Rule{}}
-			doc, _ := astparser.ParseGraphqlDocumentString(test.schema)
-
-			count, errs := store.CollectUnsuppressedDataTypeErrors(
-				&doc,
-				test.config,
-				test.schema,
-				"test.graphql",
-			)
-
-			assert.Equal(t, test.wantCount, count, "error count mismatch for %s", test.name)
-			assert.Len(t, errs, test.wantCount, "errors slice length mismatch for %s", test.name)
-
-			for _, substr := range test.wantContains {
-				found := false
-
-				for _, err := range errs {
-					if strings.Contains(err.Message, substr) {
-						found = true
-
-						break
-					}
-				}
-
-				assert.True(t, found, "expected error message containing '%s' in test %s, got %v", substr, test.name, errs)
-			}
-		})
-	}
+	configPath := filepath.Join(t.TempDir(), ".graphql-linter.yml")
+	config := `suppressions:
+  - rule: types-have-descriptions
+  - rule: [fields-have-descriptions, arguments-have-descriptions]
+    file: "**/generated/*.graphqls"
+    value: ^Legacy.*
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(config), 0o600))
+
+	store, err := NewStore(configPath, "", false)
+	require.NoError(t, err)
+
+	got, err := store.LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, models.RuleList{"types-have-descriptions"}, got.Suppressions[0].Rule)
+	assert.Equal(
+		t,
+		models.RuleList{"fields-have-descriptions", "arguments-have-descriptions"},
+		got.Suppressions[1].Rule,
+	)
 }
@@ -1,41 +1,39 @@
 package federation
 
 import (
-	log "github.com/sirupsen/logrus"
+	"fmt"
+	"strings"
+
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/federation"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/operationreport"
 )
 
-func ValidateFederationSchema(filteredSchema string) bool {
-	var report operationreport.Report
-
-	federationSchema, federationErr := federation.BuildFederationSchema(
+// ValidateFederationSchema builds the federation schema of a subgraph and
+// returns an error when the subgraph cannot be composed.
+func ValidateFederationSchema(filteredSchema string) error {
+	_, err := federation.BuildFederationSchema(
 		filteredSchema,
 		filteredSchema,
 	)
-	if federationErr != nil {
-		log.Infof("Federation schema build failed: %v\n", federationErr)
-
-		return false
+	if err != nil {
+		return fmt.Errorf("federation schema build failed: %w", err)
 	}
 
-	_ = federationSchema
+	return nil
+}
 
-	if report.HasErrors() {
-		log.Error("Federation validation errors:")
+// FilterSchemaComments drops the lines that are commented out with "//", which
+// the federation schema builder does not accept.
+func FilterSchemaComments(schemaString string) string {
+	lines := strings.Split(schemaString, "\n")
 
-		for _, internalErr := range report.InternalErrors {
-			log.Errorf("  - %v\n", internalErr)
-		}
+	var filteredLines []string
 
-		for _, externalErr := range report.ExternalErrors {
-			log.Errorf("  - %s\n", externalErr.Message)
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "//") {
+			filteredLines = append(filteredLines, line)
 		}
-
-		return false
 	}
 
-	log.Debug("Federation schema validation passed")
-
-	return true
+	return strings.Join(filteredLines, "\n")
 }
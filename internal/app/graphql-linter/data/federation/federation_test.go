@@ -1 +1,18 @@
 package federation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilterSchemaComments(t *testing.T) {
+	t.Parallel()
+
+	schema := "// comment\ntype Query { id: ID }\n// another"
+	want := "type Query { id: ID }"
+
+	got := FilterSchemaComments(schema)
+	if !strings.Contains(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -1,113 +1,136 @@
 package rules
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	baseRules "github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/federation"
 	pkgRules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
-	log "github.com/sirupsen/logrus"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
 )
 
-func reportDirectiveError(directiveName, parentName, parentKind string) {
-	log.Errorf(
-		"invalid-federation-directive: Invalid federation directive '@%s' on %s '%s'",
-		directiveName,
-		parentKind,
-		parentName,
-	)
-
-	switch parentKind {
-	case "type":
-		log.Errorf(
-			`  Federation only allows these directives: @key, @external, @requires, @provides, @extends,
-          @shareable, @inaccessible, @override, @composeDirective, @interfaceObject, @tag, @deprecated, @specifiedBy,
-          @oneOf`,
-		)
-		pkgRules.SuggestDirective(directiveName, "key")
-		pkgRules.SuggestDirective(directiveName, "external")
-	case "field":
-		log.Errorf(
-			`  Federation only allows these directives on fields: @external, @requires, @provides, @shareable,
-          @inaccessible, @override, @tag, @deprecated`,
-		)
-	}
+var validFederationDirectives = map[string]bool{
+	"key":              true,
+	"external":         true,
+	"requires":         true,
+	"provides":         true,
+	"extends":          true,
+	"shareable":        true,
+	"inaccessible":     true,
+	"override":         true,
+	"composeDirective": true,
+	"interfaceObject":  true,
+	"tag":              true,
+	"deprecated":       true, // Standard GraphQL directive
+	"specifiedBy":      true, // Standard GraphQL directive
+	"oneOf":            true, // Standard GraphQL directive
 }
 
-func validateDirectives(
+func invalidDirectives(
 	doc *ast.Document,
+	lines *baseRules.LineIndex,
 	directiveRefs []int,
 	validDirectives map[string]bool,
 	parentName, parentKind string,
-) bool {
-	hasErrors := false
+) []models.Finding {
+	var findings []models.Finding
 
 	for _, directiveRef := range directiveRefs {
 		directive := doc.Directives[directiveRef]
 
 		directiveName := doc.Input.ByteSliceString(directive.Name)
-		if !validDirectives[directiveName] {
-			reportDirectiveError(directiveName, parentName, parentKind)
+		if validDirectives[directiveName] {
+			continue
+		}
 
-			hasErrors = true
+		finding := models.Finding{
+			RuleID:     pkgRules.RuleInvalidFederationDirective,
+			Severity:   models.SeverityError,
+			Line:       int(directive.At.LineStart),
+			Column:     int(directive.At.CharStart),
+			Coordinate: parentName,
+			Message: fmt.Sprintf(
+				"Invalid federation directive '@%s' on %s '%s'",
+				directiveName,
+				parentKind,
+				parentName,
+			),
+			LineContent: lines.Content(int(directive.At.LineStart)),
 		}
-	}
 
-	return !hasErrors
-}
+		if parentKind == "type" {
+			if suggestion := pkgRules.DirectiveSuggestion(directiveName, "key", "external"); suggestion != "" {
+				finding.Suggestion = "Did you mean '@" + suggestion + "'?"
+			}
+		}
 
-func ValidateDirectiveNames(doc *ast.Document) bool {
-	validFederationDirectives := map[string]bool{
-		"key":              true,
-		"external":         true,
-		"requires":         true,
-		"provides":         true,
-		"extends":          true,
-		"shareable":        true,
-		"inaccessible":     true,
-		"override":         true,
-		"composeDirective": true,
-		"interfaceObject":  true,
-		"tag":              true,
-		"deprecated":       true, // Standard GraphQL directive
-		"specifiedBy":      true, // Standard GraphQL directive
-		"oneOf":            true, // Standard GraphQL directive
+		findings = append(findings, finding)
 	}
 
-	log.Debug("Validating federation directive names...")
+	return findings
+}
 
-	hasErrors := false
+// InvalidDirectives returns a finding for every directive on an object type or
+// field that is neither an Apollo Federation nor a built-in directive.
+func InvalidDirectives(doc *ast.Document, lines *baseRules.LineIndex) []models.Finding {
+	var findings []models.Finding
 
 	for _, obj := range doc.ObjectTypeDefinitions {
 		typeName := doc.Input.ByteSliceString(obj.Name)
-		if !validateDirectives(
+		findings = append(findings, invalidDirectives(
 			doc,
+			lines,
 			obj.Directives.Refs,
 			validFederationDirectives,
 			typeName,
 			"type",
-		) {
-			hasErrors = true
-		}
+		)...)
 	}
 
-	for _, fieldDef := range doc.FieldDefinitions {
-		fieldName := doc.Input.ByteSliceString(fieldDef.Name)
-		if !validateDirectives(
+	parents := baseRules.FieldParents(doc)
+
+	for fieldRef, fieldDef := range doc.FieldDefinitions {
+		coordinate := baseRules.FieldCoordinate(parents, fieldRef, doc.Input.ByteSliceString(fieldDef.Name))
+		findings = append(findings, invalidDirectives(
 			doc,
+			lines,
 			fieldDef.Directives.Refs,
 			validFederationDirectives,
-			fieldName,
+			coordinate,
 			"field",
-		) {
-			hasErrors = true
-		}
+		)...)
 	}
 
-	if hasErrors {
-		log.Error("Federation directive validation FAILED - schema contains invalid directives")
+	return findings
+}
+
+// CompositionErrors composes the schema into an Apollo Federation subgraph
+// schema and returns a finding for the error, if any.
+func CompositionErrors(schemaString string) []models.Finding {
+	return compositionErrors(
+		schemaString,
+		federation.ValidateFederationSchema(federation.FilterSchemaComments(schemaString)),
+	)
+}
 
-		return false
+// compositionErrors returns a finding for the error of composing the schema.
+// The error does not tell where the problem is, so the finding points at the
+// start of the schema.
+func compositionErrors(schemaString string, compositionErr error) []models.Finding {
+	if compositionErr == nil {
+		return nil
 	}
 
-	log.Debug("federation directive validation PASSED")
+	firstLine, _, _ := strings.Cut(schemaString, "\n")
 
-	return true
+	return []models.Finding{{
+		RuleID:      pkgRules.RuleFederationCompositionError,
+		Severity:    models.SeverityError,
+		Line:        1,
+		Column:      1,
+		Message:     "The schema cannot be composed into a federation subgraph: " + compositionErr.Error(),
+		LineContent: strings.TrimSpace(firstLine),
+	}}
 }
@@ -1,104 +1,51 @@
 package rules
 
 import (
+	"errors"
 	"testing"
 
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	baseRules "github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	pkgRules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
 )
 
-func TestValidateDirectiveNames(t *testing.T) {
+func TestCompositionErrors(t *testing.T) {
 	t.Parallel()
 
-	doc, _ := astparser.ParseGraphqlDocumentString("type Query { id: ID } directive @key on OBJECT")
-
-	tests := []struct {
-		name string
-		doc  *ast.Document
-		want bool
-	}{
-		{"valid directives", &doc, true},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			got := ValidateDirectiveNames(test.doc)
-			if got != test.want {
-				t.Errorf("got %v, want %v", got, test.want)
-			}
-		})
-	}
-}
-
-func TestValidateDirectiveNames_Invalid(t *testing.T) {
-	t.Parallel()
-
-	doc, _ := astparser.ParseGraphqlDocumentString("type Query @invalid { id: ID }")
-
-	got := ValidateDirectiveNames(&doc)
-	if got {
-		t.Errorf("expected false for invalid directive, got true")
-	}
+	assert.Empty(t, CompositionErrors("type Query { id: ID }"))
+	assert.Empty(t, compositionErrors("type Query { id: ID }", nil))
+
+	findings := compositionErrors("  type Query { id: ID }\n", errors.New("unknown type _Any"))
+	require.Len(t, findings, 1)
+	assert.Equal(t, models.Finding{
+		RuleID:      pkgRules.RuleFederationCompositionError,
+		Severity:    models.SeverityError,
+		Line:        1,
+		Column:      1,
+		Message:     "The schema cannot be composed into a federation subgraph: unknown type _Any",
+		LineContent: "type Query { id: ID }",
+	}, findings[0])
 }
 
-func TestValidateDirectives(t *testing.T) {
+func TestInvalidDirectives(t *testing.T) {
 	t.Parallel()
 
-	doc, _ := astparser.ParseGraphqlDocumentString("type Query { id: ID } directive @key on OBJECT")
-	valid := map[string]bool{"key": true}
+	doc, _ := astparser.ParseGraphqlDocumentString("type Query @kye {\n  id: ID @foo\n  name: String @external\n}")
 
-	tests := []struct {
-		name       string
-		directives []int
-		want       bool
-	}{
-		{"valid", []int{}, true},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
+	findings := InvalidDirectives(&doc, baseRules.NewLineIndex(doc.Input.RawBytes))
+	require.Len(t, findings, 2)
 
-			got := validateDirectives(&doc, test.directives, valid, "Query", "type")
-			if got != test.want {
-				t.Errorf("got %v, want %v", got, test.want)
-			}
-		})
-	}
-}
-
-func TestValidateDirectives_MoreCases(t *testing.T) {
-	t.Parallel()
+	assert.Equal(t, "invalid-federation-directive", findings[0].RuleID)
+	assert.Equal(t, models.SeverityError, findings[0].Severity)
+	assert.Equal(t, "Query", findings[0].Coordinate)
+	assert.Equal(t, 1, findings[0].Line)
+	assert.Equal(t, "Did you mean '@key'?", findings[0].Suggestion)
 
-	doc, _ := astparser.ParseGraphqlDocumentString("type Query @invalid { id: ID }")
-	valid := map[string]bool{"key": true}
-	// Find the directive refs for the first object type
-	var directiveRefs []int
-	if len(doc.ObjectTypeDefinitions) > 0 {
-		directiveRefs = doc.ObjectTypeDefinitions[0].Directives.Refs
-	}
-
-	got := validateDirectives(&doc, directiveRefs, valid, "Query", "type")
-	if got {
-		t.Errorf("expected false for invalid directive, got true")
-	}
-
-	invalid := map[string]bool{"invalid": false}
-
-	got2 := validateDirectives(&doc, []int{}, invalid, "Query", "type")
-	if !got2 {
-		t.Errorf("expected true for no directives, got false")
-	}
-}
-
-func TestReportDirectiveError(t *testing.T) {
-	t.Parallel()
-	// Just ensure it doesn't panic
-	reportDirectiveError("invalid", "Query", "type")
-}
-
-func TestReportDirectiveError_AllKinds(t *testing.T) {
-	t.Parallel()
-	reportDirectiveError("invalid", "Query", "type")
-	reportDirectiveError("invalid", "fieldName", "field")
+	assert.Equal(t, "Query.id", findings[1].Coordinate)
+	assert.Equal(t, 2, findings[1].Line)
+	assert.Equal(t, "id: ID @foo", findings[1].LineContent)
+	assert.Empty(t, findings[1].Suggestion)
 }
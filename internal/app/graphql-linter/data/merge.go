@@ -0,0 +1,79 @@
+package data
+
+import (
+	"strings"
+)
+
+// MergedSchema is the concatenation of several schema files. Linting it as one
+// document lets rules see types that are defined, used or extended in another
+// file, while Locate attributes a merged line back to the file it came from.
+type MergedSchema struct {
+	Source   string
+	segments []schemaSegment
+}
+
+type schemaSegment struct {
+	path      string
+	source    string
+	startLine int
+	lineCount int
+}
+
+// MergeSchemas concatenates the given schema sources, which are expected in
+// the same order as their paths.
+func MergeSchemas(paths, sources []string) MergedSchema {
+	var (
+		builder  strings.Builder
+		segments = make([]schemaSegment, 0, len(paths))
+	)
+
+	startLine := 1
+
+	for i, path := range paths {
+		source := sources[i]
+		if !strings.HasSuffix(source, "\n") {
+			source += "\n"
+		}
+
+		lineCount := strings.Count(source, "\n")
+		segments = append(segments, schemaSegment{
+			path:      path,
+			source:    sources[i],
+			startLine: startLine,
+			lineCount: lineCount,
+		})
+
+		builder.WriteString(source)
+
+		startLine += lineCount
+	}
+
+	return MergedSchema{
+		Source:   builder.String(),
+		segments: segments,
+	}
+}
+
+// Locate returns the file and the line within that file of a line in the
+// merged source. Lines that cannot be attributed, such as the unknown line 0,
+// are returned unchanged with an empty path.
+func (m MergedSchema) Locate(line int) (string, int) {
+	for _, segment := range m.segments {
+		if line >= segment.startLine && line < segment.startLine+segment.lineCount {
+			return segment.path, line - segment.startLine + 1
+		}
+	}
+
+	return "", line
+}
+
+// FileSource returns the original source of one of the merged files.
+func (m MergedSchema) FileSource(path string) string {
+	for _, segment := range m.segments {
+		if segment.path == path {
+			return segment.source
+		}
+	}
+
+	return ""
+}
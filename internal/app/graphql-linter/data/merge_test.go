@@ -0,0 +1,36 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSchemas(t *testing.T) {
+	t.Parallel()
+
+	merged := MergeSchemas(
+		[]string{"user.graphql", "order.graphql"},
+		[]string{"type User {\n  id: ID\n}", "type Order {\n  buyer: User\n}\n"},
+	)
+
+	assert.Equal(t, "type User {\n  id: ID\n}\ntype Order {\n  buyer: User\n}\n", merged.Source)
+
+	tests := []struct {
+		line     int
+		wantPath string
+		wantLine int
+	}{
+		{0, "", 0},
+		{1, "user.graphql", 1},
+		{3, "user.graphql", 3},
+		{4, "order.graphql", 1},
+		{6, "order.graphql", 3},
+		{7, "", 7},
+	}
+	for _, test := range tests {
+		path, line := merged.Locate(test.line)
+		assert.Equal(t, test.wantPath, path, "path of merged line %d", test.line)
+		assert.Equal(t, test.wantLine, line, "line of merged line %d", test.line)
+	}
+}
@@ -7,7 +7,6 @@ package mocks
 import (
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 	mock "github.com/stretchr/testify/mock"
-	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
 )
 
 // NewStorer creates a new instance of Storer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -93,7 +92,7 @@ func (_c *Storer_FindAndLogGraphQLSchemaFiles_Call) RunAndReturn(run func() ([]s
 }
 
 // LintSchemaFiles provides a mock function for the type Storer
-func (_mock *Storer) LintSchemaFiles(schemaFiles []string) (int, int, []models.DescriptionError) {
+func (_mock *Storer) LintSchemaFiles(schemaFiles []string) (int, int, []models.Finding) {
 	ret := _mock.Called(schemaFiles)
 
 	if len(ret) == 0 {
@@ -102,8 +101,8 @@ func (_mock *Storer) LintSchemaFiles(schemaFiles []string) (int, int, []models.D
 
 	var r0 int
 	var r1 int
-	var r2 []models.DescriptionError
-	if returnFunc, ok := ret.Get(0).(func([]string) (int, int, []models.DescriptionError)); ok {
+	var r2 []models.Finding
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, int, []models.Finding)); ok {
 		return returnFunc(schemaFiles)
 	}
 	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
@@ -116,11 +115,11 @@ func (_mock *Storer) LintSchemaFiles(schemaFiles []string) (int, int, []models.D
 	} else {
 		r1 = ret.Get(1).(int)
 	}
-	if returnFunc, ok := ret.Get(2).(func([]string) []models.DescriptionError); ok {
+	if returnFunc, ok := ret.Get(2).(func([]string) []models.Finding); ok {
 		r2 = returnFunc(schemaFiles)
 	} else {
 		if ret.Get(2) != nil {
-			r2 = ret.Get(2).([]models.DescriptionError)
+			r2 = ret.Get(2).([]models.Finding)
 		}
 	}
 	return r0, r1, r2
@@ -150,12 +149,12 @@ func (_c *Storer_LintSchemaFiles_Call) Run(run func(schemaFiles []string)) *Stor
 	return _c
 }
 
-func (_c *Storer_LintSchemaFiles_Call) Return(n int, n1 int, descriptionErrors []models.DescriptionError) *Storer_LintSchemaFiles_Call {
+func (_c *Storer_LintSchemaFiles_Call) Return(n int, n1 int, descriptionErrors []models.Finding) *Storer_LintSchemaFiles_Call {
 	_c.Call.Return(n, n1, descriptionErrors)
 	return _c
 }
 
-func (_c *Storer_LintSchemaFiles_Call) RunAndReturn(run func(schemaFiles []string) (int, int, []models.DescriptionError)) *Storer_LintSchemaFiles_Call {
+func (_c *Storer_LintSchemaFiles_Call) RunAndReturn(run func(schemaFiles []string) (int, int, []models.Finding)) *Storer_LintSchemaFiles_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -214,62 +213,3 @@ func (_c *Storer_LoadConfig_Call) RunAndReturn(run func() (*models.LinterConfig,
 	_c.Call.Return(run)
 	return _c
 }
-
-// UnsortedTypeFields provides a mock function for the type Storer
-func (_mock *Storer) UnsortedTypeFields(doc *ast.Document, schemaString string) []models.DescriptionError {
-	ret := _mock.Called(doc, schemaString)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UnsortedTypeFields")
-	}
-
-	var r0 []models.DescriptionError
-	if returnFunc, ok := ret.Get(0).(func(*ast.Document, string) []models.DescriptionError); ok {
-		r0 = returnFunc(doc, schemaString)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DescriptionError)
-		}
-	}
-	return r0
-}
-
-// Storer_UnsortedTypeFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsortedTypeFields'
-type Storer_UnsortedTypeFields_Call struct {
-	*mock.Call
-}
-
-// UnsortedTypeFields is a helper method to define mock.On call
-//   - doc *ast.Document
-//   - schemaString string
-func (_e *Storer_Expecter) UnsortedTypeFields(doc any, schemaString any) *Storer_UnsortedTypeFields_Call {
-	return &Storer_UnsortedTypeFields_Call{Call: _e.mock.On("UnsortedTypeFields", doc, schemaString)}
-}
-
-func (_c *Storer_UnsortedTypeFields_Call) Run(run func(doc *ast.Document, schemaString string)) *Storer_UnsortedTypeFields_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *ast.Document
-		if args[0] != nil {
-			arg0 = args[0].(*ast.Document)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		run(
-			arg0,
-			arg1,
-		)
-	})
-	return _c
-}
-
-func (_c *Storer_UnsortedTypeFields_Call) Return(descriptionErrors []models.DescriptionError) *Storer_UnsortedTypeFields_Call {
-	_c.Call.Return(descriptionErrors)
-	return _c
-}
-
-func (_c *Storer_UnsortedTypeFields_Call) RunAndReturn(run func(doc *ast.Document, schemaString string) []models.DescriptionError) *Storer_UnsortedTypeFields_Call {
-	_c.Call.Return(run)
-	return _c
-}
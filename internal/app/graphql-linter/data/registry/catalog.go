@@ -0,0 +1,531 @@
+package registry
+
+import (
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	federation_rules "github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/federation/rules"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+)
+
+var (
+	checkDescriptions = Option{
+		Setting:     "checkDescriptions",
+		Description: "The rule only runs when descriptions are checked.",
+		enabled:     func(settings models.Settings) bool { return settings.CheckDescriptions },
+	}
+	validateFederation = Option{
+		Setting:     "validateFederation",
+		Description: "The rule only runs when the schema is validated as a federation subgraph.",
+		enabled:     func(settings models.Settings) bool { return settings.ValidateFederation },
+	}
+	requireSuppressionReason = Option{
+		Setting:     "requireSuppressionReason",
+		Description: "The rule only runs when suppressions must have a reason.",
+		enabled:     func(settings models.Settings) bool { return settings.RequireSuppressionReason },
+	}
+	reportUnusedSuppressions = Option{
+		Setting:     "reportUnusedSuppressions",
+		Description: "The rule only reports findings when unused suppressions are reported, otherwise they are logged.",
+		enabled:     func(settings models.Settings) bool { return settings.ReportUnusedSuppressions },
+	}
+)
+
+// catalog holds the rules sorted by identifier.
+var catalog = []Rule{
+	{
+		ID:              pkg_rules.RuleArgumentsHaveDescriptions,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Field arguments must have a description.",
+		Rationale: "Arguments show up in the documentation and in the autocompletion of GraphQL clients. " +
+			"A description tells the consumers of the API what value to pass without reading the " +
+			"resolver.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `type Query {
+  """Returns a user."""
+  user(id: ID!): User
+}`,
+			Valid: `type Query {
+  """Returns a user."""
+  user(
+    """The identifier of the user."""
+    id: ID!
+  ): User
+}`,
+		}},
+		Check: document(rules.MissingArgumentDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleDefinedTypesAreUsed,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Every type that is defined must be referenced by a field, argument or union.",
+		Rationale: "A type that no field, argument or union refers to cannot be queried. It is usually " +
+			"left over from a refactoring and only makes the schema harder to read.",
+		Examples: []Example{{
+			Invalid: `type Query {
+  name: String
+}
+
+type User {
+  name: String
+}`,
+			Valid: `type Query {
+  user: User
+}
+
+type User {
+  name: String
+}`,
+		}},
+		Check: document(rules.UnusedTypes),
+	},
+	{
+		ID:              pkg_rules.RuleDeprecationsHaveAReason,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Every @deprecated directive must provide a reason.",
+		Rationale: "Clients keep using a deprecated field or enum value until they know what to use " +
+			"instead. The reason is shown next to the deprecation in tooling and should point at " +
+			"the replacement.",
+		Examples: []Example{{
+			Invalid: `enum Status {
+  ACTIVE
+  INACTIVE @deprecated
+}`,
+			Valid: `enum Status {
+  ACTIVE
+  INACTIVE @deprecated(reason: "Use ACTIVE instead.")
+}`,
+		}},
+		Check: document(rules.MissingDeprecationReasons),
+	},
+	{
+		ID:              pkg_rules.RuleDescriptionsAreCapitalized,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Descriptions must start with a capital letter.",
+		Rationale: "Descriptions are rendered as documentation. Starting them with a capital letter " +
+			"keeps that documentation consistent.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `"""the query root."""
+type Query {
+  id: ID
+}`,
+			Valid: `"""The query root."""
+type Query {
+  id: ID
+}`,
+		}},
+		Check: document(rules.UncapitalizedDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleEnumValuesHaveDescriptions,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Enum values must have a description.",
+		Rationale: "The meaning of an enum value is rarely obvious from its name alone. A description " +
+			"documents it for the consumers of the API.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `enum Color {
+  BLUE
+}`,
+			Valid: `enum Color {
+  """The color of the sky."""
+  BLUE
+}`,
+		}},
+		Check: document(rules.MissingEnumValueDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleEnumValuesSortedAlphabetically,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Enum values must be sorted in alphabetical order.",
+		Rationale: "Sorted enum values are easier to scan and produce smaller diffs, as new values have " +
+			"an obvious place.",
+		Examples: []Example{{
+			Invalid: `enum Priority {
+  LOW
+  HIGH
+}`,
+			Valid: `enum Priority {
+  HIGH
+  LOW
+}`,
+		}},
+		Check: document(rules.EnumValuesSortedAlphabetically),
+	},
+	{
+		ID:              pkg_rules.RuleExpiredSuppression,
+		Category:        pkg_rules.CategorySuppression,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Suppressions must not be used past their until date.",
+		Rationale: "A suppression with an until date is meant to be temporary. Once the date has passed, " +
+			"the finding should be fixed or the suppression renewed deliberately.",
+	},
+	{
+		ID:              pkg_rules.RuleFailedToReadSchemaFile,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Every schema file must be readable.",
+		Rationale:       "A schema file that cannot be read is not linted, so its problems would go unnoticed.",
+	},
+	{
+		ID:              pkg_rules.RuleFederationCompositionError,
+		Category:        pkg_rules.CategoryFederation,
+		DefaultSeverity: models.SeverityError,
+		Description:     "The schema must compose into an Apollo Federation subgraph schema.",
+		Rationale: "A subgraph schema that cannot be composed is rejected by the Apollo Federation " +
+			"gateway or router, so the problem is better caught before deployment.",
+		Options: []Option{validateFederation},
+		Check: func(ctx Context) []models.Finding {
+			return federation_rules.CompositionErrors(ctx.Schema)
+		},
+	},
+	{
+		ID:              pkg_rules.RuleFieldsAreCamelCased,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Object type field names must be camelCased.",
+		Rationale: "camelCase is the naming convention for fields in GraphQL. A consistent convention " +
+			"makes the API predictable for its consumers.",
+		Examples: []Example{{
+			Invalid: `type User {
+  first_name: String
+}`,
+			Valid: `type User {
+  firstName: String
+}`,
+		}},
+		Check: document(rules.FieldsAreCamelCased),
+	},
+	{
+		ID:              pkg_rules.RuleFieldsHaveDescriptions,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Object type fields must have a description.",
+		Rationale: "Fields are the main surface of the API. A description tells the consumers what a " +
+			"field returns without reading the resolver.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `type User {
+  name: String
+}`,
+			Valid: `type User {
+  """The full name of the user."""
+  name: String
+}`,
+		}},
+		Check: document(rules.MissingFieldDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleInputObjectFieldsSortedAlphabetically,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Input object fields must be sorted in alphabetical order.",
+		Rationale: "Sorted input fields are easier to scan and produce smaller diffs, as new fields have " +
+			"an obvious place.",
+		Examples: []Example{{
+			Invalid: `input UserInput {
+  name: String
+  email: String
+}`,
+			Valid: `input UserInput {
+  email: String
+  name: String
+}`,
+		}},
+		Check: document(rules.InputObjectFieldsSortedAlphabetically),
+	},
+	{
+		ID:              pkg_rules.RuleInputObjectValuesAreCamelCased,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Input object field names must be camelCased.",
+		Rationale: "camelCase is the naming convention for input fields in GraphQL. A consistent " +
+			"convention makes the API predictable for its consumers.",
+		Examples: []Example{{
+			Invalid: `input UserInput {
+  first_name: String
+}`,
+			Valid: `input UserInput {
+  firstName: String
+}`,
+		}},
+		Check: document(rules.InputObjectValuesCamelCased),
+	},
+	{
+		ID:              pkg_rules.RuleInputObjectValuesHaveDescriptions,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Input object fields must have a description.",
+		Rationale: "Input fields show up in the documentation and in the autocompletion of GraphQL " +
+			"clients. A description tells the consumers what value to pass.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `input UserInput {
+  name: String
+}`,
+			Valid: `input UserInput {
+  """The full name of the user."""
+  name: String
+}`,
+		}},
+		Check: document(rules.MissingInputObjectValueDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleInterfaceFieldsSortedAlphabetically,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Interface fields must be sorted in alphabetical order.",
+		Rationale: "Sorted interface fields are easier to scan and produce smaller diffs, as new fields " +
+			"have an obvious place.",
+		Examples: []Example{{
+			Invalid: `interface Node {
+  name: String
+  id: ID!
+}`,
+			Valid: `interface Node {
+  id: ID!
+  name: String
+}`,
+		}},
+		Check: document(rules.UnsortedInterfaceFields),
+	},
+	{
+		ID:              pkg_rules.RuleInvalidFederationDirective,
+		Category:        pkg_rules.CategoryFederation,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Only Apollo Federation and built-in directives may be used on types and fields.",
+		Rationale: "A directive that Apollo Federation does not know is either a typo of a federation " +
+			"directive, such as @keys for @key, or is dropped during composition.",
+		Options: []Option{validateFederation},
+		Examples: []Example{{
+			Invalid: `type User @keys(fields: "id") {
+  id: ID!
+}`,
+			Valid: `type User @key(fields: "id") {
+  id: ID!
+}`,
+		}},
+		Check: document(federation_rules.InvalidDirectives),
+	},
+	{
+		ID:              pkg_rules.RuleInvalidGraphQLSchema,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "The schema must be valid GraphQL.",
+		Rationale: "The other rules can only check a schema that is valid GraphQL, so they skip a file " +
+			"that cannot be parsed.",
+	},
+	{
+		ID:              pkg_rules.RuleMissingQueryRootType,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "The schema must provide a Query root type.",
+		Rationale:       "A GraphQL service needs a Query root type to be served.",
+		SchemaWide:      true,
+		Examples: []Example{{
+			Invalid: `type Mutation {
+  ping: Boolean
+}`,
+			Valid: `type Query {
+  ping: Boolean
+}`,
+		}},
+		Check: document(rules.MissingQueryRootType),
+	},
+	{
+		ID:              pkg_rules.RuleMissingSuppressionReason,
+		Category:        pkg_rules.CategorySuppression,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Suppressions must explain why they are needed.",
+		Rationale: "A suppression without a reason cannot be reviewed later. The reason explains why the " +
+			"finding is accepted.",
+		Options: []Option{requireSuppressionReason},
+	},
+	{
+		ID:              pkg_rules.RuleReferencedTypesAreDefined,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityWarning,
+		Description:     "Fields, arguments and input fields must have a built-in or defined type.",
+		Rationale: "A type that is referenced but not defined makes the schema invalid. It is usually a " +
+			"typo or a type that has been renamed or removed.",
+		Examples: []Example{{
+			Invalid: `type Query {
+  user: Usr
+}
+
+type User {
+  name: String
+}`,
+			Valid: `type Query {
+  user: User
+}
+
+type User {
+  name: String
+}`,
+		}},
+		Check: document(rules.UndefinedTypes),
+	},
+	{
+		ID:              pkg_rules.RuleRelayConnectionArgumentsSpec,
+		Category:        pkg_rules.CategoryRelay,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Fields returning a Connection must accept forward and/or backward pagination arguments.",
+		Rationale: "The Relay connection specification pages through a connection with the first and " +
+			"after or the last and before arguments. Clients such as Relay rely on them.",
+		Examples: []Example{{
+			Invalid: `type Query {
+  users: UserConnection
+}`,
+			Valid: `type Query {
+  users(first: Int, after: String): UserConnection
+}`,
+		}},
+		Check: document(rules.RelayConnectionArgumentsSpec),
+	},
+	{
+		ID:              pkg_rules.RuleRelayConnectionTypesSpec,
+		Category:        pkg_rules.CategoryRelay,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Connection types must expose the edges and pageInfo fields.",
+		Rationale: "The Relay connection specification requires a connection type to expose its edges " +
+			"and a pageInfo field. Clients such as Relay rely on them.",
+		Examples: []Example{{
+			Invalid: `type UserConnection {
+  edges: [UserEdge]
+}`,
+			Valid: `type UserConnection {
+  edges: [UserEdge]
+  pageInfo: PageInfo!
+}`,
+		}},
+		Check: document(rules.RelayConnectionTypesSpec),
+	},
+	{
+		ID:              pkg_rules.RuleRelayPageInfoSpec,
+		Category:        pkg_rules.CategoryRelay,
+		DefaultSeverity: models.SeverityError,
+		Description:     "A PageInfo object type must be defined.",
+		Rationale: "Connections following the Relay specification return their paging state in a " +
+			"PageInfo object type.",
+		SchemaWide: true,
+		Examples: []Example{{
+			Invalid: `type Query {
+  ping: Boolean
+}`,
+			Valid: `type Query {
+  ping: Boolean
+}
+
+type PageInfo {
+  endCursor: String
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+}`,
+		}},
+		Check: document(rules.RelayPageInfoSpec),
+	},
+	{
+		ID:              pkg_rules.RuleSuspiciousEnumValue,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Enum values should not contain digits that look like typos.",
+		Rationale: "Digits in enum values, such as INACTIVE1, are usually typos. Fixing them after " +
+			"clients depend on the value is a breaking change.",
+		Examples: []Example{{
+			Invalid: `enum Status {
+  ACTIVE
+  INACTIVE1
+}`,
+			Valid: `enum Status {
+  ACTIVE
+  INACTIVE
+}`,
+		}},
+		Check: func(ctx Context) []models.Finding {
+			_, _, findings := rules.ValidateEnumTypes(ctx.Document, ctx.Lines)
+
+			return findings
+		},
+	},
+	{
+		ID:              pkg_rules.RuleTypeFieldsSortedAlphabetically,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Object type fields must be sorted in alphabetical order.",
+		Rationale: "Sorted fields are easier to scan and produce smaller diffs, as new fields have an " +
+			"obvious place.",
+		Examples: []Example{{
+			Invalid: `type User {
+  name: String
+  id: ID!
+}`,
+			Valid: `type User {
+  id: ID!
+  name: String
+}`,
+		}},
+		Check: document(rules.UnsortedTypeFields),
+	},
+	{
+		ID:              pkg_rules.RuleTypesAreCapitalized,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Object type names must start with a capital letter.",
+		Rationale: "PascalCase is the naming convention for types in GraphQL. A consistent convention " +
+			"makes the API predictable for its consumers.",
+		Examples: []Example{{
+			Invalid: `type user {
+  id: ID!
+}`,
+			Valid: `type User {
+  id: ID!
+}`,
+		}},
+		Check: document(rules.TypesAreCapitalized),
+	},
+	{
+		ID:              pkg_rules.RuleTypesHaveDescriptions,
+		Category:        pkg_rules.CategorySchema,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Object types must have a description.",
+		Rationale: "Types show up in the documentation of the API. A description tells the consumers " +
+			"what a type represents.",
+		Options: []Option{checkDescriptions},
+		Examples: []Example{{
+			Invalid: `type User {
+  id: ID!
+}`,
+			Valid: `"""A person with an account."""
+type User {
+  id: ID!
+}`,
+		}},
+		Check: document(rules.MissingTypeDescriptions),
+	},
+	{
+		ID:              pkg_rules.RuleUnusedSuppression,
+		Category:        pkg_rules.CategorySuppression,
+		DefaultSeverity: models.SeverityError,
+		Description:     "Suppressions must match at least one finding.",
+		Rationale: "A suppression that no longer matches a finding hides nothing, but it would silence a " +
+			"new finding that happens to match it.",
+		Options: []Option{reportUnusedSuppressions},
+	},
+}
+
+// document adapts a check that only looks at the schema document.
+func document(check func(doc *ast.Document, lines *rules.LineIndex) []models.Finding) func(Context) []models.Finding {
+	return func(ctx Context) []models.Finding {
+		return check(ctx.Document, ctx.Lines)
+	}
+}
@@ -0,0 +1,126 @@
+// Package registry holds every rule of the linter together with its metadata.
+// Listing, configuring, documenting and running the rules is driven by it.
+package registry
+
+import (
+	"slices"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/ast"
+)
+
+// Context is what a rule checks: a schema that has been parsed without errors,
+// the index of its lines and the configuration that applies to it.
+type Context struct {
+	Document *ast.Document
+	Schema   string
+	Lines    *rules.LineIndex
+	Config   *models.LinterConfig
+}
+
+// Option is a setting of the configuration that turns a rule on or off.
+type Option struct {
+	Setting     string
+	Description string
+
+	enabled func(models.Settings) bool
+}
+
+// Example is a schema that the rule reports and the same schema once the
+// finding has been fixed.
+type Example struct {
+	Invalid string
+	Valid   string
+}
+
+// Rule is a single check of the linter. Check is nil for the rules that the
+// linter reports itself, such as the rules about suppressions. Fixable reports
+// whether the linter can fix the findings of the rule, which none of the rules
+// support yet. SchemaWide rules report on the schema as a whole, such as a type
+// that is missing, rather than on a definition in it.
+type Rule struct {
+	ID              string
+	Category        string
+	DefaultSeverity models.Severity
+	Description     string
+	Rationale       string
+	Fixable         bool
+	SchemaWide      bool
+	Options         []Option
+	Examples        []Example
+	Check           func(ctx Context) []models.Finding
+}
+
+// Rules returns the rules known by the linter, sorted by identifier.
+func Rules() []Rule {
+	return slices.Clone(catalog)
+}
+
+// Lookup returns the rule with the given identifier.
+func Lookup(id string) (Rule, bool) {
+	for _, rule := range catalog {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// Level returns the level of a rule: the one in the rules section of the
+// configuration, under its identifier or one that its findings were reported
+// under before, or, when it is not listed there, the level that matches the
+// default severity of the rule.
+func Level(config *models.LinterConfig, id string) models.RuleLevel {
+	if config != nil {
+		for _, reference := range append([]string{id}, pkg_rules.PreviousRuleIDs(id)...) {
+			if level, ok := config.Rules[reference]; ok {
+				return level
+			}
+		}
+	}
+
+	rule, ok := Lookup(id)
+	if ok && rule.DefaultSeverity == models.SeverityWarning {
+		return models.RuleLevelWarn
+	}
+
+	return models.RuleLevelError
+}
+
+// Enabled reports whether the rule runs under the configuration, which
+// depends on its level and on the settings of its options.
+func (r Rule) Enabled(config *models.LinterConfig) bool {
+	if config == nil {
+		return true
+	}
+
+	if Level(config, r.ID) == models.RuleLevelOff {
+		return false
+	}
+
+	for _, option := range r.Options {
+		if !option.enabled(config.Settings) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Run runs the checks of the enabled rules on the schema.
+func Run(ctx Context) []models.Finding {
+	var findings []models.Finding
+
+	for _, rule := range Rules() {
+		if rule.Check == nil || !rule.Enabled(ctx.Config) {
+			continue
+		}
+
+		findings = append(findings, rule.Check(ctx)...)
+	}
+
+	return findings
+}
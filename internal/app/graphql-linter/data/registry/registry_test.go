@@ -0,0 +1,157 @@
+package registry
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+)
+
+func TestRules_SortedAndUnique(t *testing.T) {
+	t.Parallel()
+
+	ids := make([]string, 0, len(Rules()))
+	seen := make(map[string]bool)
+
+	for _, rule := range Rules() {
+		assert.False(t, seen[rule.ID], "duplicate rule %s", rule.ID)
+		assert.NotEmpty(t, rule.Description, "rule %s has no description", rule.ID)
+		assert.NotEmpty(t, rule.Rationale, "rule %s has no rationale", rule.ID)
+		assert.NotEmpty(t, rule.Category, "rule %s has no category", rule.ID)
+		assert.NotEmpty(t, rule.DefaultSeverity, "rule %s has no default severity", rule.ID)
+
+		seen[rule.ID] = true
+		ids = append(ids, rule.ID)
+	}
+
+	assert.True(t, sort.StringsAreSorted(ids), "rules are not sorted: %v", ids)
+}
+
+// TestRules_Examples checks that every rule reports its invalid example and
+// accepts its valid one.
+func TestRules_Examples(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range Rules() {
+		for _, example := range rule.Examples {
+			t.Run(rule.ID, func(t *testing.T) {
+				t.Parallel()
+
+				require.NotNil(t, rule.Check)
+				assert.Contains(t, ruleIDs(t, rule, example.Invalid), rule.ID, "invalid example")
+				assert.NotContains(t, ruleIDs(t, rule, example.Valid), rule.ID, "valid example")
+			})
+		}
+	}
+}
+
+func ruleIDs(t *testing.T, rule Rule, schema string) []string {
+	t.Helper()
+
+	doc, report := astparser.ParseGraphqlDocumentString(schema)
+	require.False(t, report.HasErrors(), report.Error())
+
+	ctx := Context{Document: &doc, Schema: schema, Lines: rules.NewLineIndex(doc.Input.RawBytes)}
+
+	var ids []string
+
+	for _, finding := range rule.Check(ctx) {
+		ids = append(ids, finding.RuleID)
+	}
+
+	return ids
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	rule, ok := Lookup("types-have-descriptions")
+	assert.True(t, ok)
+	assert.Equal(t, pkg_rules.CategorySchema, rule.Category)
+
+	_, ok = Lookup("does-not-exist")
+	assert.False(t, ok)
+}
+
+func TestRule_Enabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings models.Settings
+		ruleID   string
+		want     bool
+	}{
+		{"defaults", models.NewLinterConfig().Settings, "fields-have-descriptions", true},
+		{"descriptions disabled", models.Settings{ValidateFederation: true}, "fields-have-descriptions", false},
+		{"capitalization disabled", models.Settings{ValidateFederation: true}, "descriptions-are-capitalized", false},
+		{"other rule with descriptions disabled", models.Settings{}, "fields-are-camel-cased", true},
+		{"federation disabled", models.Settings{CheckDescriptions: true}, "invalid-federation-directive", false},
+		{"federation enabled", models.Settings{ValidateFederation: true}, "invalid-federation-directive", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, ok := Lookup(test.ruleID)
+			require.True(t, ok)
+			assert.Equal(t, test.want, rule.Enabled(&models.LinterConfig{Settings: test.settings}))
+		})
+	}
+
+	rule, ok := Lookup("fields-have-descriptions")
+	require.True(t, ok)
+
+	config := models.NewLinterConfig()
+	config.Rules = map[string]models.RuleLevel{"fields-have-descriptions": models.RuleLevelOff}
+	assert.False(t, rule.Enabled(config))
+	assert.True(t, rule.Enabled(nil))
+}
+
+func TestLevel(t *testing.T) {
+	t.Parallel()
+
+	config := &models.LinterConfig{Rules: map[string]models.RuleLevel{
+		pkg_rules.RuleTypesHaveDescriptions: models.RuleLevelWarn,
+	}}
+
+	assert.Equal(t, models.RuleLevelWarn, Level(config, pkg_rules.RuleTypesHaveDescriptions))
+	assert.Equal(t, models.RuleLevelError, Level(config, pkg_rules.RuleFieldsHaveDescriptions))
+	assert.Equal(t, models.RuleLevelError, Level(nil, pkg_rules.RuleFieldsHaveDescriptions))
+
+	config.Rules[pkg_rules.RuleInvalidGraphQLSchema] = models.RuleLevelOff
+	assert.Equal(t, models.RuleLevelOff, Level(config, pkg_rules.RuleMissingQueryRootType))
+
+	config.Rules[pkg_rules.RuleMissingQueryRootType] = models.RuleLevelWarn
+	assert.Equal(t, models.RuleLevelWarn, Level(config, pkg_rules.RuleMissingQueryRootType))
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	schema := "type Query {\n  first_name: String\n}\n"
+	doc, _ := astparser.ParseGraphqlDocumentString(schema)
+
+	config := models.NewLinterConfig()
+	config.Settings.CheckDescriptions = false
+	config.Settings.ValidateFederation = false
+
+	ids := make(map[string]bool)
+	for _, finding := range Run(Context{
+		Document: &doc,
+		Schema:   schema,
+		Lines:    rules.NewLineIndex(doc.Input.RawBytes),
+		Config:   config,
+	}) {
+		ids[finding.RuleID] = true
+	}
+
+	assert.True(t, ids[pkg_rules.RuleFieldsAreCamelCased])
+	assert.True(t, ids[pkg_rules.RuleRelayPageInfoSpec])
+	assert.False(t, ids[pkg_rules.RuleTypesHaveDescriptions])
+}
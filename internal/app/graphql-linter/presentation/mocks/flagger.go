@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"flag"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,6 +37,52 @@ func (_m *Flagger) EXPECT() *Flagger_Expecter {
 	return &Flagger_Expecter{mock: &_m.Mock}
 }
 
+// Args provides a mock function for the type Flagger
+func (_mock *Flagger) Args() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Args")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// Flagger_Args_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Args'
+type Flagger_Args_Call struct {
+	*mock.Call
+}
+
+// Args is a helper method to define mock.On call
+func (_e *Flagger_Expecter) Args() *Flagger_Args_Call {
+	return &Flagger_Args_Call{Call: _e.mock.On("Args")}
+}
+
+func (_c *Flagger_Args_Call) Run(run func()) *Flagger_Args_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Flagger_Args_Call) Return(strings []string) *Flagger_Args_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *Flagger_Args_Call) RunAndReturn(run func() []string) *Flagger_Args_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BoolVar provides a mock function for the type Flagger
 func (_mock *Flagger) BoolVar(p *bool, name string, value bool, usage string) {
 	_mock.Called(p, name, value, usage)
@@ -183,3 +231,55 @@ func (_c *Flagger_StringVar_Call) RunAndReturn(run func(p *string, name string,
 	_c.Run(run)
 	return _c
 }
+
+// Var provides a mock function for the type Flagger
+func (_mock *Flagger) Var(value flag.Value, name string, usage string) {
+	_mock.Called(value, name, usage)
+	return
+}
+
+// Flagger_Var_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Var'
+type Flagger_Var_Call struct {
+	*mock.Call
+}
+
+// Var is a helper method to define mock.On call
+//   - value flag.Value
+//   - name string
+//   - usage string
+func (_e *Flagger_Expecter) Var(value any, name any, usage any) *Flagger_Var_Call {
+	return &Flagger_Var_Call{Call: _e.mock.On("Var", value, name, usage)}
+}
+
+func (_c *Flagger_Var_Call) Run(run func(value flag.Value, name string, usage string)) *Flagger_Var_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 flag.Value
+		if args[0] != nil {
+			arg0 = args[0].(flag.Value)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Flagger_Var_Call) Return() *Flagger_Var_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Flagger_Var_Call) RunAndReturn(run func(value flag.Value, name string, usage string)) *Flagger_Var_Call {
+	_c.Run(run)
+	return _c
+}
@@ -36,20 +36,29 @@ func (_m *Presenter) EXPECT() *Presenter_Expecter {
 }
 
 // Run provides a mock function for the type Presenter
-func (_mock *Presenter) Run() error {
+func (_mock *Presenter) Run() (int, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for Run")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // Presenter_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
@@ -69,12 +78,12 @@ func (_c *Presenter_Run_Call) Run(run func()) *Presenter_Run_Call {
 	return _c
 }
 
-func (_c *Presenter_Run_Call) Return(err error) *Presenter_Run_Call {
-	_c.Call.Return(err)
+func (_c *Presenter_Run_Call) Return(n int, err error) *Presenter_Run_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *Presenter_Run_Call) RunAndReturn(run func() error) *Presenter_Run_Call {
+func (_c *Presenter_Run_Call) RunAndReturn(run func() (int, error)) *Presenter_Run_Call {
 	_c.Call.Return(run)
 	return _c
 }
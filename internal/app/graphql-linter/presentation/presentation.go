@@ -1,31 +1,68 @@
 package presentation
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application/report"
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	commandExplain        = "explain"
+	commandInit           = "init"
+	commandRules          = "rules"
+	defaultConfigFileName = ".graphql-linter.yml"
+)
+
+// The exit codes of the linter.
+const (
+	// ExitCodeClean means that no finding fails the run.
+	ExitCodeClean = 0
+	// ExitCodeFindings means that at least one finding fails the run.
+	ExitCodeFindings = 1
+	// ExitCodeUsageError means that the linter could not run, for example
+	// because of an invalid flag or configuration file.
+	ExitCodeUsageError = 2
+	// ExitCodeInvalidSchema means that a schema could not be parsed or is not
+	// a valid GraphQL schema.
+	ExitCodeInvalidSchema = 3
+)
+
 type Presenter interface {
-	Run() error
+	Run() (int, error)
 }
 
 type Flagger interface {
+	Args() []string
 	BoolVar(p *bool, name string, value bool, usage string)
 	StringVar(p *string, name string, value string, usage string)
+	Var(value flag.Value, name string, usage string)
 	Parse()
 }
 
 type Flag struct{}
 
 type CLI struct {
-	configPathFlag string
-	targetPathFlag string
-	version        string
-	versionFlag    bool
-	verboseFlag    bool
+	args              []string
+	baselineFlag      string
+	configPathFlag    string
+	formatFlag        string
+	mergeFlag         bool
+	excludeFlags      listFlag
+	gitignoreFlag     bool
+	includeFlags      listFlag
+	outputFlags       listFlag
+	stdinFilenameFlag string
+	targetPathFlag    string
+	version           string
+	versionFlag       bool
+	verboseFlag       bool
+	writeBaselineFlag string
 }
 
 func NewCLI(flagger Flagger, version string) CLI {
@@ -44,49 +81,301 @@ func NewCLI(flagger Flagger, version string) CLI {
 		"",
 		"The directory with GraphQL files that should be checked",
 	)
+	flagger.StringVar(
+		&cli.formatFlag,
+		"format",
+		string(report.FormatText),
+		"The report format, one of: "+report.FormatNames(),
+	)
+	flagger.Var(
+		&cli.outputFlags,
+		"output",
+		"Write the report to a file as format=path, or as path in the -format format (repeatable)",
+	)
+	flagger.StringVar(
+		&cli.baselineFlag,
+		"baseline",
+		"",
+		"Only report findings that are not recorded in this baseline file",
+	)
+	flagger.StringVar(
+		&cli.writeBaselineFlag,
+		"write-baseline",
+		"",
+		"Record the current findings in this baseline file",
+	)
+	flagger.Var(
+		&cli.includeFlags,
+		"include",
+		"Lint the files that match this glob pattern instead of *.graphql and *.graphqls files (repeatable)",
+	)
+	flagger.Var(&cli.excludeFlags, "exclude", "Skip the files and directories that match this glob pattern (repeatable)")
+	flagger.BoolVar(&cli.gitignoreFlag, "gitignore", false, "Skip the files that are ignored by .gitignore files")
+	flagger.StringVar(
+		&cli.stdinFilenameFlag,
+		"stdin-filename",
+		"",
+		"The file name to report, match suppressions against and find the configuration for when reading from stdin (-)",
+	)
+	flagger.BoolVar(
+		&cli.mergeFlag,
+		"merge",
+		false,
+		"Lint all schema files as one schema, so types may be defined, used and extended across files",
+	)
 	flagger.BoolVar(&cli.versionFlag, "version", false, "Show version")
 	flagger.BoolVar(&cli.verboseFlag, "verbose", false, "Enable verbose output")
 	flagger.Parse()
 
+	cli.args = flagger.Args()
+
 	return cli
 }
 
+// listFlag collects every occurrence of a repeatable flag, such as -output.
+type listFlag []string
+
+func (o *listFlag) String() string {
+	return strings.Join(*o, ",")
+}
+
+func (o *listFlag) Set(value string) error {
+	*o = append(*o, value)
+
+	return nil
+}
+
 func NewFlag() Flag {
 	return Flag{}
 }
 
-func (c CLI) Run() error {
+// Run lints the files and directories given as arguments, or runs the init,
+// rules or explain command when the first argument names one. It returns the
+// exit code of the linter along with the error, if any.
+func (c CLI) Run() (int, error) {
+	if len(c.args) > 0 {
+		var command func([]string) error
+
+		switch c.args[0] {
+		case commandInit:
+			command = c.runInit
+		case commandRules:
+			command = runRules
+		case commandExplain:
+			command = runExplain
+		}
+
+		if command != nil {
+			err := command(c.args[1:])
+			if err != nil {
+				return ExitCodeUsageError, err
+			}
+
+			return ExitCodeClean, nil
+		}
+	}
+
+	result, err := c.runLint()
+
+	return exitCode(result, err), err
+}
+
+// exitCode maps the outcome of a lint run to the exit code of the linter.
+func exitCode(result application.Result, err error) int {
+	switch {
+	case err != nil:
+		return ExitCodeUsageError
+	case result.InvalidSchemas > 0:
+		return ExitCodeInvalidSchema
+	case result.Summary.TotalErrors > 0:
+		return ExitCodeFindings
+	default:
+		return ExitCodeClean
+	}
+}
+
+func (c CLI) runLint() (application.Result, error) {
+	format, err := report.ParseFormat(c.formatFlag)
+	if err != nil {
+		return application.Result{}, fmt.Errorf("invalid format flag: %w", err)
+	}
+
+	outputs := make([]report.Output, 0, len(c.outputFlags))
+
+	for _, value := range c.outputFlags {
+		output, parseErr := report.ParseOutput(value, format)
+		if parseErr != nil {
+			return application.Result{}, fmt.Errorf("invalid output flag: %w", parseErr)
+		}
+
+		outputs = append(outputs, output)
+	}
+
 	applicationExecute, err := application.NewExecute(
 		application.NewDebug(),
 		c.configPathFlag,
 		c.targetPathFlag,
 		c.version,
+		format,
+		outputs,
+		c.baselineFlag,
+		c.writeBaselineFlag,
+		application.Discovery{
+			Include:   c.includeFlags,
+			Exclude:   c.excludeFlags,
+			Gitignore: c.gitignoreFlag,
+		},
+		application.Input{
+			Paths:         c.args,
+			Stdin:         os.Stdin,
+			StdinFilename: c.stdinFilenameFlag,
+		},
+		c.mergeFlag,
 		c.verboseFlag,
 	)
 	if err != nil {
-		return fmt.Errorf("unable to load new execute: %w", err)
+		return application.Result{}, fmt.Errorf("unable to load new execute: %w", err)
 	}
 
 	if c.versionFlag {
 		log.Info(applicationExecute.Version())
 
-		return nil
+		return application.Result{}, nil
 	}
 
 	if c.verboseFlag {
-		log.Info("Verbose output enabled")
-		log.SetLevel(log.DebugLevel)
-		log.SetReportCaller(true)
+		enableVerboseOutput()
 	}
 
-	err = applicationExecute.Run()
+	result, err := applicationExecute.Run()
 	if err != nil {
-		return fmt.Errorf("unable to run execute: %w", err)
+		return application.Result{}, fmt.Errorf("unable to run execute: %w", err)
+	}
+
+	return result, nil
+}
+
+// runInit writes a commented configuration file, see application.Init.
+func (c CLI) runInit(args []string) error {
+	flags := flag.NewFlagSet(commandInit, flag.ContinueOnError)
+	configPath := flags.String("configPath", defaultConfigFileName, "The path of the configuration file to write")
+	targetPath := flags.String("targetPath", "", "The directory with GraphQL files that should be checked")
+	fromFindings := flags.Bool(
+		"from-findings",
+		false,
+		"Add a suppression for every current finding in the target path",
+	)
+	merge := flags.Bool("merge", false, "Lint all schema files as one schema when collecting findings")
+	verbose := flags.Bool("verbose", false, "Enable verbose output")
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s flags: %w", commandInit, err)
+	}
+
+	if *verbose {
+		enableVerboseOutput()
+	}
+
+	applicationExecute, err := application.NewExecute(
+		application.NewDebug(),
+		"",
+		*targetPath,
+		c.version,
+		report.FormatText,
+		nil,
+		"",
+		"",
+		application.Discovery{},
+		application.Input{Paths: flags.Args(), Stdin: os.Stdin},
+		*merge,
+		*verbose,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to load new execute: %w", err)
+	}
+
+	err = applicationExecute.Init(*configPath, *fromFindings)
+	if err != nil {
+		return fmt.Errorf("unable to run %s: %w", commandInit, err)
+	}
+
+	return nil
+}
+
+// runRules lists every rule of the linter, see application.ListRules.
+func runRules(args []string) error {
+	flags := flag.NewFlagSet(commandRules, flag.ContinueOnError)
+	format := flags.String(
+		"format",
+		application.RulesFormatTable,
+		"The output format, one of: "+application.RulesFormatTable+", "+application.RulesFormatJSON,
+	)
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s flags: %w", commandRules, err)
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%s takes no arguments, got: %s", commandRules, strings.Join(flags.Args(), " "))
+	}
+
+	err = application.ListRules(os.Stdout, *format)
+	if err != nil {
+		return fmt.Errorf("unable to run %s: %w", commandRules, err)
 	}
 
 	return nil
 }
 
+// runExplain documents a single rule, see application.ExplainRule.
+func runExplain(args []string) error {
+	flags := flag.NewFlagSet(commandExplain, flag.ContinueOnError)
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s flags: %w", commandExplain, err)
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf(
+			"%s expects exactly one rule, for example: %s fields-have-descriptions",
+			commandExplain,
+			commandExplain,
+		)
+	}
+
+	err = application.ExplainRule(os.Stdout, flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("unable to run %s: %w", commandExplain, err)
+	}
+
+	return nil
+}
+
+func enableVerboseOutput() {
+	log.Info("Verbose output enabled")
+	log.SetLevel(log.DebugLevel)
+	log.SetReportCaller(true)
+}
+
+func (f Flag) Args() []string {
+	return flag.Args()
+}
+
 func (f Flag) BoolVar(p *bool, name string, value bool, usage string) {
 	flag.BoolVar(p, name, value, usage)
 }
@@ -95,6 +384,10 @@ func (f Flag) StringVar(p *string, name string, value string, usage string) {
 	flag.StringVar(p, name, value, usage)
 }
 
+func (f Flag) Var(value flag.Value, name string, usage string) {
+	flag.Var(value, name, usage)
+}
+
 func (f Flag) Parse() {
 	flag.Parse()
 }
@@ -1,8 +1,11 @@
 package presentation
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/application/report"
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/presentation/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,9 +31,67 @@ func TestNewCLI(t *testing.T) {
 		"The directory with GraphQL files that should be checked",
 	).Times(1)
 
+	mocksFlagger.EXPECT().StringVar(
+		mock.Anything,
+		"format",
+		"text",
+		"The report format, one of: text, json, sarif, junit, checkstyle, github, gitlab",
+	).Times(1)
+
+	mocksFlagger.EXPECT().Var(
+		mock.Anything,
+		"output",
+		"Write the report to a file as format=path, or as path in the -format format (repeatable)",
+	).Times(1)
+
+	mocksFlagger.EXPECT().StringVar(
+		mock.Anything,
+		"baseline",
+		"",
+		"Only report findings that are not recorded in this baseline file",
+	).Times(1)
+
+	mocksFlagger.EXPECT().StringVar(
+		mock.Anything,
+		"write-baseline",
+		"",
+		"Record the current findings in this baseline file",
+	).Times(1)
+
+	mocksFlagger.EXPECT().Var(
+		mock.Anything,
+		"include",
+		"Lint the files that match this glob pattern instead of *.graphql and *.graphqls files (repeatable)",
+	).Times(1)
+	mocksFlagger.EXPECT().Var(
+		mock.Anything,
+		"exclude",
+		"Skip the files and directories that match this glob pattern (repeatable)",
+	).Times(1)
+	mocksFlagger.EXPECT().BoolVar(
+		mock.Anything,
+		"gitignore",
+		false,
+		"Skip the files that are ignored by .gitignore files",
+	).Times(1)
+
+	mocksFlagger.EXPECT().StringVar(
+		mock.Anything,
+		"stdin-filename",
+		"",
+		"The file name to report, match suppressions against and find the configuration for when reading from stdin (-)",
+	).Times(1)
+
+	mocksFlagger.EXPECT().BoolVar(
+		mock.Anything,
+		"merge",
+		false,
+		"Lint all schema files as one schema, so types may be defined, used and extended across files",
+	).Times(1)
 	mocksFlagger.EXPECT().BoolVar(mock.Anything, "version", false, "Show version").Times(1)
 	mocksFlagger.EXPECT().BoolVar(mock.Anything, "verbose", false, "Enable verbose output").Times(1)
 	mocksFlagger.EXPECT().Parse().Times(1)
+	mocksFlagger.EXPECT().Args().Return(nil).Times(1)
 
 	cli := NewCLI(mocksFlagger, "1.0.0")
 	require.NotNil(t, cli)
@@ -38,6 +99,98 @@ func TestNewCLI(t *testing.T) {
 	assert.Equal(t, "1.0.0", cli.version)
 	assert.False(t, cli.versionFlag)
 	assert.False(t, cli.verboseFlag)
+	assert.False(t, cli.mergeFlag)
+	assert.Empty(t, cli.baselineFlag)
+	assert.Empty(t, cli.writeBaselineFlag)
+	assert.Empty(t, cli.outputFlags)
+	assert.Empty(t, cli.includeFlags)
+	assert.Empty(t, cli.excludeFlags)
+	assert.False(t, cli.gitignoreFlag)
+	assert.Empty(t, cli.stdinFilenameFlag)
+	assert.Empty(t, cli.args)
 
 	mocksFlagger.AssertExpectations(t)
 }
+
+func TestListFlag_Set(t *testing.T) {
+	t.Parallel()
+
+	var outputs listFlag
+
+	require.NoError(t, outputs.Set("sarif=lint.sarif"))
+	require.NoError(t, outputs.Set("junit=lint.xml"))
+
+	assert.Equal(t, listFlag{"sarif=lint.sarif", "junit=lint.xml"}, outputs)
+	assert.Equal(t, "sarif=lint.sarif,junit=lint.xml", outputs.String())
+}
+
+func TestRunInit_InvalidFlag(t *testing.T) {
+	t.Parallel()
+
+	code, err := CLI{args: []string{"init", "-no-such-flag"}}.Run()
+
+	assert.ErrorContains(t, err, "invalid init flags")
+	assert.Equal(t, ExitCodeUsageError, code)
+}
+
+func TestRun_RulesAndExplainUsageErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"rules flag", []string{"rules", "-no-such-flag"}, "invalid rules flags"},
+		{"rules format", []string{"rules", "-format", "yaml"}, "unsupported rules format: 'yaml'"},
+		{"rules argument", []string{"rules", "extra"}, "rules takes no arguments, got: extra"},
+		{"explain without rule", []string{"explain"}, "explain expects exactly one rule"},
+		{"explain unknown rule", []string{"explain", "field-are-camel-cased"}, "did you mean 'fields-are-camel-cased'?"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := CLI{args: test.args}.Run()
+
+			assert.ErrorContains(t, err, test.want)
+			assert.Equal(t, ExitCodeUsageError, code)
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	findings := application.Result{Summary: report.Summary{TotalErrors: 2}}
+	invalidSchema := application.Result{Summary: report.Summary{TotalErrors: 2}, InvalidSchemas: 1}
+
+	tests := []struct {
+		name   string
+		result application.Result
+		err    error
+		want   int
+	}{
+		{"clean", application.Result{}, nil, ExitCodeClean},
+		{"findings", findings, nil, ExitCodeFindings},
+		{"invalid schema finding", invalidSchema, nil, ExitCodeInvalidSchema},
+		{"config error", application.Result{}, errors.New("unable to load config"), ExitCodeUsageError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, exitCode(test.result, test.err))
+		})
+	}
+}
+
+func TestRun_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	code, err := CLI{formatFlag: "yaml"}.Run()
+
+	assert.ErrorContains(t, err, "invalid format flag")
+	assert.Equal(t, ExitCodeUsageError, code)
+}
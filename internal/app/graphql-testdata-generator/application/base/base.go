@@ -959,7 +959,7 @@ func WriteQueryRootMustBeProvidedSchemaToFile(outputDir string) error {
 	doc := GenerateQueryRootMustBeProvidedSchema()
 	gql := data.GenerateGraphQLFromDocument(doc)
 
-	outputPath := filepath.Join(outputDir, "19-invalid-graphql-schema.graphql")
+	outputPath := filepath.Join(outputDir, "19-missing-query-root-type.graphql")
 
 	err := os.MkdirAll(filepath.Dir(outputPath), constants.DirPerm)
 	if err != nil {
@@ -0,0 +1,63 @@
+package rules
+
+import "slices"
+
+const (
+	CategoryFederation = "federation"
+	CategoryRelay      = "relay"
+	CategorySchema     = "schema"
+	// CategorySuppression holds the rules that check the configured
+	// suppressions rather than the schema.
+	CategorySuppression = "suppression"
+)
+
+const (
+	RuleArgumentsHaveDescriptions             = "arguments-have-descriptions"
+	RuleDefinedTypesAreUsed                   = "defined-types-are-used"
+	RuleDeprecationsHaveAReason               = "deprecations-have-a-reason"
+	RuleDescriptionsAreCapitalized            = "descriptions-are-capitalized"
+	RuleEnumValuesHaveDescriptions            = "enum-values-have-descriptions"
+	RuleEnumValuesSortedAlphabetically        = "enum-values-sorted-alphabetically"
+	RuleExpiredSuppression                    = "expired-suppression"
+	RuleFailedToReadSchemaFile                = "failed-to-read-schema-file"
+	RuleFederationCompositionError            = "federation-composition-error"
+	RuleFieldsAreCamelCased                   = "fields-are-camel-cased"
+	RuleFieldsHaveDescriptions                = "fields-have-descriptions"
+	RuleInputObjectFieldsSortedAlphabetically = "input-object-fields-sorted-alphabetically"
+	RuleInputObjectValuesAreCamelCased        = "input-object-values-are-camel-cased"
+	RuleInputObjectValuesHaveDescriptions     = "input-object-values-have-descriptions"
+	RuleInterfaceFieldsSortedAlphabetically   = "interface-fields-sorted-alphabetically"
+	RuleInvalidFederationDirective            = "invalid-federation-directive"
+	RuleInvalidGraphQLSchema                  = "invalid-graphql-schema"
+	RuleMissingQueryRootType                  = "missing-query-root-type"
+	RuleMissingSuppressionReason              = "missing-suppression-reason"
+	RuleReferencedTypesAreDefined             = "referenced-types-are-defined"
+	RuleRelayConnectionArgumentsSpec          = "relay-connection-arguments-spec"
+	RuleRelayConnectionTypesSpec              = "relay-connection-types-spec"
+	RuleRelayPageInfoSpec                     = "relay-page-info-spec"
+	RuleSuspiciousEnumValue                   = "suspicious-enum-value"
+	RuleTypeFieldsSortedAlphabetically        = "type-fields-sorted-alphabetically"
+	RuleTypesAreCapitalized                   = "types-are-capitalized"
+	RuleTypesHaveDescriptions                 = "types-have-descriptions"
+	RuleUnusedSuppression                     = "unused-suppression"
+)
+
+// previousRuleIDs maps a rule to the identifiers that its findings were
+// reported under before it got its own, so that the suppressions, baselines
+// and rule levels that were written for them keep applying.
+var previousRuleIDs = map[string][]string{
+	RuleMissingQueryRootType: {RuleInvalidGraphQLSchema},
+}
+
+// PreviousRuleIDs returns the identifiers that the findings of a rule were
+// reported under before.
+func PreviousRuleIDs(ruleID string) []string {
+	return previousRuleIDs[ruleID]
+}
+
+// RuleMatches reports whether the rule that a suppression, baseline or
+// configuration refers to is the rule of a finding, either by its identifier
+// or by one that its findings were reported under before.
+func RuleMatches(reference, ruleID string) bool {
+	return reference == ruleID || slices.Contains(previousRuleIDs[ruleID], reference)
+}
@@ -0,0 +1,156 @@
+package rules
+
+import (
+	"strings"
+)
+
+const (
+	inlineDisable         = "graphql-linter-disable"
+	inlineDisableNextLine = "graphql-linter-disable-next-line"
+	inlineEnable          = "graphql-linter-enable"
+	// The graphql-schema-linter equivalents of inlineDisable and inlineEnable.
+	lintDisable = "lint-disable"
+	lintEnable  = "lint-enable"
+	// allRules is the key of a directive that does not list any rule.
+	allRules = "*"
+	// untilEndOfFile is the end line of a block that is never enabled again.
+	untilEndOfFile = 0
+)
+
+// InlineSuppressions are the suppressions written as comments in a schema
+// file:
+//
+//	# graphql-linter-disable-next-line fields-have-descriptions -- reason
+//	# graphql-linter-disable types-have-descriptions
+//	# graphql-linter-enable types-have-descriptions
+//
+// A directive without rules applies to every rule, and a block that is not
+// enabled again lasts until the end of the file. For compatibility with
+// graphql-schema-linter, "# lint-disable" and "# lint-enable" are accepted as
+// well.
+type InlineSuppressions struct {
+	ranges []inlineRange
+}
+
+type inlineRange struct {
+	rule      string
+	startLine int
+	endLine   int
+}
+
+// ParseInlineSuppressions collects the inline suppressions of a schema.
+func ParseInlineSuppressions(schema string) InlineSuppressions {
+	var (
+		suppressions InlineSuppressions
+		openBlocks   = make(map[string]int)
+	)
+
+	for index, line := range strings.Split(schema, "\n") {
+		lineNum := index + 1
+
+		directive, ruleIDs, ok := parseInlineDirective(line)
+		if !ok {
+			continue
+		}
+
+		switch directive {
+		case inlineDisableNextLine:
+			for _, ruleID := range ruleIDs {
+				suppressions.ranges = append(suppressions.ranges, inlineRange{
+					rule:      ruleID,
+					startLine: lineNum + 1,
+					endLine:   lineNum + 1,
+				})
+			}
+		case inlineDisable, lintDisable:
+			for _, ruleID := range ruleIDs {
+				if _, open := openBlocks[ruleID]; !open {
+					openBlocks[ruleID] = lineNum + 1
+				}
+			}
+		case inlineEnable, lintEnable:
+			if len(ruleIDs) == 1 && ruleIDs[0] == allRules {
+				ruleIDs = make([]string, 0, len(openBlocks))
+				for ruleID := range openBlocks {
+					ruleIDs = append(ruleIDs, ruleID)
+				}
+			}
+
+			for _, ruleID := range ruleIDs {
+				startLine, open := openBlocks[ruleID]
+				if !open {
+					continue
+				}
+
+				suppressions.ranges = append(suppressions.ranges, inlineRange{
+					rule:      ruleID,
+					startLine: startLine,
+					endLine:   lineNum - 1,
+				})
+
+				delete(openBlocks, ruleID)
+			}
+		}
+	}
+
+	for ruleID, startLine := range openBlocks {
+		suppressions.ranges = append(suppressions.ranges, inlineRange{
+			rule:      ruleID,
+			startLine: startLine,
+			endLine:   untilEndOfFile,
+		})
+	}
+
+	return suppressions
+}
+
+// IsSuppressed reports whether an inline suppression covers the rule on the
+// given line.
+func (s InlineSuppressions) IsSuppressed(line int, ruleID string) bool {
+	for _, suppression := range s.ranges {
+		if suppression.rule != allRules && !RuleMatches(suppression.rule, ruleID) {
+			continue
+		}
+
+		if line < suppression.startLine ||
+			(suppression.endLine != untilEndOfFile && line > suppression.endLine) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// parseInlineDirective splits a "# <directive> <rules> -- <reason>" comment
+// into the directive and its rules. Rules may be separated by commas and/or
+// whitespace. The reason is only meant for readers of the schema.
+func parseInlineDirective(line string) (string, []string, bool) {
+	comment, found := strings.CutPrefix(strings.TrimSpace(line), "#")
+	if !found {
+		return "", nil, false
+	}
+
+	comment, _, _ = strings.Cut(comment, "--")
+
+	fields := strings.FieldsFunc(comment, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+
+	switch fields[0] {
+	case inlineDisable, inlineDisableNextLine, inlineEnable, lintDisable, lintEnable:
+	default:
+		return "", nil, false
+	}
+
+	ruleIDs := fields[1:]
+	if len(ruleIDs) == 0 {
+		ruleIDs = []string{allRules}
+	}
+
+	return fields[0], ruleIDs, true
+}
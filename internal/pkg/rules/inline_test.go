@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+)
+
+const inlineSchema = `# graphql-linter-disable-next-line fields-have-descriptions -- generated
+type Query {
+  a: String
+}
+
+# graphql-linter-disable types-have-descriptions, enum-values-have-descriptions
+enum Legacy {
+  A
+}
+# graphql-linter-enable types-have-descriptions
+
+# lint-disable
+type Generated {
+  b: String
+}
+`
+
+func TestInlineSuppressions_IsSuppressed(t *testing.T) {
+	t.Parallel()
+
+	suppressions := ParseInlineSuppressions(inlineSchema)
+
+	tests := []struct {
+		name     string
+		line     int
+		rule     string
+		expected bool
+	}{
+		{"next line", 2, RuleFieldsHaveDescriptions, true},
+		{"next line only", 3, RuleFieldsHaveDescriptions, false},
+		{"next line other rule", 2, RuleTypesHaveDescriptions, false},
+		{"block", 7, RuleTypesHaveDescriptions, true},
+		{"block second rule", 8, RuleEnumValuesHaveDescriptions, true},
+		{"after enable", 11, RuleTypesHaveDescriptions, false},
+		{"still disabled without enable", 11, RuleEnumValuesHaveDescriptions, true},
+		{"lint-disable all rules", 13, RuleTypesHaveDescriptions, true},
+		{"lint-disable until end of file", 14, RuleFieldsHaveDescriptions, true},
+		{"before any directive", 1, RuleTypesHaveDescriptions, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := suppressions.IsSuppressed(test.line, test.rule)
+			if got != test.expected {
+				t.Errorf("line %d %s: got %v, want %v", test.line, test.rule, got, test.expected)
+			}
+		})
+	}
+}
+
+func TestInlineSuppressions_PreviousRuleIDs(t *testing.T) {
+	t.Parallel()
+
+	suppressions := ParseInlineSuppressions("# graphql-linter-disable-next-line invalid-graphql-schema\ntype Foo {\n")
+
+	if !suppressions.IsSuppressed(2, RuleMissingQueryRootType) {
+		t.Errorf("expected the previous rule ID to suppress missing-query-root-type")
+	}
+
+	if suppressions.IsSuppressed(2, RuleTypesHaveDescriptions) {
+		t.Errorf("expected other rules not to be suppressed")
+	}
+}
+
+func TestInlineSuppressions_EnableAll(t *testing.T) {
+	t.Parallel()
+
+	suppressions := ParseInlineSuppressions(
+		"# graphql-linter-disable types-have-descriptions\ntype A\n# graphql-linter-enable\ntype B\n",
+	)
+
+	if !suppressions.IsSuppressed(2, RuleTypesHaveDescriptions) {
+		t.Errorf("expected line 2 to be suppressed")
+	}
+
+	if suppressions.IsSuppressed(4, RuleTypesHaveDescriptions) {
+		t.Errorf("expected line 4 not to be suppressed")
+	}
+}
+
+func TestParseInlineDirective_IgnoresOtherComments(t *testing.T) {
+	t.Parallel()
+
+	for _, line := range []string{"# just a comment", "type Query", "#", "# graphql-linter-disabled"} {
+		if _, _, ok := parseInlineDirective(line); ok {
+			t.Errorf("expected %q not to be a directive", line)
+		}
+	}
+}
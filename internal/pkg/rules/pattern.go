@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+)
+
+const globMetaCharacters = "*?["
+
+var errInvalidGlob = errors.New("unterminated character class")
+
+// MatchPath reports whether a path pattern, such as the file of a suppression
+// or an include or exclude pattern, matches the path. A glob pattern such as
+// "**/generated/*.graphqls" has to match the whole path or a part of it that
+// starts at a directory boundary, any other value has to be a suffix of the
+// path.
+func MatchPath(pattern, path string) bool {
+	if !isGlob(pattern) {
+		return strings.HasSuffix(path, pattern)
+	}
+
+	expression, err := CompileGlob(pattern)
+	if err != nil {
+		return false
+	}
+
+	for start := 0; ; {
+		if expression.MatchString(path[start:]) {
+			return true
+		}
+
+		next := strings.Index(path[start:], "/")
+		if next < 0 {
+			return false
+		}
+
+		start += next + 1
+	}
+}
+
+// matchValue reports whether the value of a suppression matches the value of
+// a finding. A value anchored with ^ or $ is a regular expression, a value
+// with glob metacharacters a glob pattern, and any other value has to match
+// exactly.
+func matchValue(pattern, value string) bool {
+	expression, err := valueRegexp(pattern)
+	if err != nil {
+		return false
+	}
+
+	if expression == nil {
+		return pattern == value
+	}
+
+	return expression.MatchString(value)
+}
+
+// ValidatePathPattern returns an error when a glob pattern for paths does not
+// compile.
+func ValidatePathPattern(pattern string) error {
+	if !isGlob(pattern) {
+		return nil
+	}
+
+	_, err := CompileGlob(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid path pattern '%s': %w", pattern, err)
+	}
+
+	return nil
+}
+
+// ValidateSuppressionPatterns returns an error when the file or value of a
+// suppression is not a valid pattern.
+func ValidateSuppressionPatterns(suppression models.Suppression) error {
+	if isGlob(suppression.File) {
+		_, err := CompileGlob(suppression.File)
+		if err != nil {
+			return fmt.Errorf("invalid file pattern '%s': %w", suppression.File, err)
+		}
+	}
+
+	_, err := valueRegexp(suppression.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value pattern '%s': %w", suppression.Value, err)
+	}
+
+	return nil
+}
+
+// LiteralValue returns a suppression value that matches exactly the given
+// value of a finding, escaping values that would otherwise be read as a
+// pattern.
+func LiteralValue(value string) string {
+	if !isGlob(value) && !strings.HasPrefix(value, "^") && !strings.HasSuffix(value, "$") {
+		return value
+	}
+
+	return "^" + regexp.QuoteMeta(value) + "$"
+}
+
+// valueRegexp compiles the value of a suppression, or returns nil when the
+// value has to match exactly.
+func valueRegexp(pattern string) (*regexp.Regexp, error) {
+	switch {
+	case strings.HasPrefix(pattern, "^") || strings.HasSuffix(pattern, "$"):
+		expression, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regular expression: %w", err)
+		}
+
+		return expression, nil
+	case isGlob(pattern):
+		return CompileGlob(pattern)
+	default:
+		return nil, nil //nolint:nilnil // nil means that the value is matched exactly.
+	}
+}
+
+func isGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, globMetaCharacters)
+}
+
+// CompileGlob translates a glob pattern into an anchored regular expression.
+// "*" and "?" do not match a "/", "**" matches any number of directories and
+// "[...]" is a character class.
+func CompileGlob(pattern string) (*regexp.Regexp, error) {
+	var builder strings.Builder
+
+	builder.WriteString("^")
+
+	for index := 0; index < len(pattern); index++ {
+		switch pattern[index] {
+		case '*':
+			if strings.HasPrefix(pattern[index:], "**/") {
+				builder.WriteString("(?:.*/)?")
+				index += 2
+			} else if strings.HasPrefix(pattern[index:], "**") {
+				builder.WriteString(".*")
+				index++
+			} else {
+				builder.WriteString("[^/]*")
+			}
+		case '?':
+			builder.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[index+1:], ']')
+			if end < 0 {
+				return nil, errInvalidGlob
+			}
+
+			class := pattern[index+1 : index+1+end]
+			if negated, found := strings.CutPrefix(class, "!"); found {
+				class = "^" + negated
+			}
+
+			builder.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			index += end + 1
+		default:
+			builder.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		}
+	}
+
+	builder.WriteString("$")
+
+	expression, err := regexp.Compile(builder.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile glob pattern: %w", err)
+	}
+
+	return expression, nil
+}
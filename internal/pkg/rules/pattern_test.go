@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"user.graphqls", "schema/user.graphqls", true},
+		{"user.graphqls", "schema/order.graphqls", false},
+		{"**/generated/*.graphqls", "schema/generated/user.graphqls", true},
+		{"**/generated/*.graphqls", "generated/user.graphqls", true},
+		{"**/generated/*.graphqls", "schema/generated/v1/user.graphqls", false},
+		{"**/generated/**", "schema/generated/v1/user.graphqls", true},
+		{"generated/*.graphqls", "/abs/schema/generated/user.graphqls", true},
+		{"generated/*.graphqls", "/abs/schema/notgenerated/user.graphqls", false},
+		{"schema/user?.graphqls", "schema/user1.graphqls", true},
+		{"schema/user[!0-9].graphqls", "schema/user1.graphqls", false},
+		{"schema/[", "schema/[", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, MatchPath(test.pattern, test.path))
+		})
+	}
+}
+
+func TestMatchValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"PageInfo", "PageInfo", true},
+		{"PageInfo", "PageInfoV2", false},
+		{"^Legacy.*", "LegacyUser", true},
+		{"^Legacy.*", "User", false},
+		{"Input$", "UserInput", true},
+		{"Legacy*", "LegacyUser", true},
+		{"*Connection", "UserConnection", true},
+		{"*Connection", "UserEdge", false},
+		{"^(", "(", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.value, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, matchValue(test.pattern, test.value))
+		})
+	}
+}
+
+func TestValidateSuppressionPatterns(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateSuppressionPatterns(models.Suppression{File: "**/*.graphqls", Value: "^Legacy.*"}))
+	assert.ErrorContains(t, ValidateSuppressionPatterns(models.Suppression{File: "schema/[a"}), "invalid file pattern")
+	assert.ErrorContains(t, ValidateSuppressionPatterns(models.Suppression{Value: "^(Legacy"}), "invalid value pattern")
+}
+
+func TestLiteralValue(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"Query.users", "Query.users(first:)", "[B, A]", "price$", "^top"} {
+		literal := LiteralValue(value)
+
+		assert.True(t, matchValue(literal, value), literal)
+		assert.False(t, matchValue(literal, value+"x"), literal)
+	}
+
+	assert.Equal(t, "Query.users", LiteralValue("Query.users"))
+}
+
+func TestMatches_RuleList(t *testing.T) {
+	t.Parallel()
+
+	suppression := models.Suppression{Rule: models.RuleList{"fields-have-descriptions", "types-have-descriptions"}}
+
+	assert.True(t, Matches("a.graphqls", 1, "types-have-descriptions", suppression, ""))
+	assert.False(t, Matches("a.graphqls", 1, "fields-are-camel-cased", suppression, ""))
+}
+
+func TestIsFindingSuppressed_MatchesValueAgainstCoordinate(t *testing.T) {
+	t.Parallel()
+
+	config := &models.LinterConfig{Suppressions: []models.Suppression{{
+		File:  "**/generated/*.graphqls",
+		Rule:  models.RuleList{RuleFieldsHaveDescriptions},
+		Value: "^Legacy.*",
+	}}}
+
+	legacy := models.Finding{RuleID: RuleFieldsHaveDescriptions, Coordinate: "LegacyUser.name"}
+	current := models.Finding{RuleID: RuleFieldsHaveDescriptions, Coordinate: "User.name"}
+
+	assert.True(t, IsFindingSuppressed("schema/generated/user.graphqls", legacy, config))
+	assert.False(t, IsFindingSuppressed("schema/generated/user.graphqls", current, config))
+	assert.False(t, IsFindingSuppressed("schema/user.graphqls", legacy, config))
+}
@@ -1,10 +1,11 @@
 package rules
 
 import (
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
-	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -12,11 +13,34 @@ const (
 	LevenshteinThreshold = 3
 )
 
-func SuggestDirective(directiveName, validName string) {
-	if strings.Contains(directiveName, validName) ||
-		LevenshteinDistance(directiveName, validName) <= LevenshteinThreshold {
-		log.Errorf("  Did you mean '@%s'?", validName)
+// DirectiveSuggestion returns the first of the valid directive names that
+// resembles the given directive name, or an empty string if none does.
+func DirectiveSuggestion(directiveName string, validNames ...string) string {
+	for _, validName := range validNames {
+		if strings.Contains(directiveName, validName) ||
+			LevenshteinDistance(directiveName, validName) <= LevenshteinThreshold {
+			return validName
+		}
+	}
+
+	return ""
+}
+
+// ClosestMatch returns the candidate that is closest to value, or an empty
+// string if none of them is close enough to be a likely typo.
+func ClosestMatch(value string, candidates []string) string {
+	closest := ""
+	closestDistance := LevenshteinThreshold + 1
+
+	for _, candidate := range candidates {
+		distance := LevenshteinDistance(value, candidate)
+		if distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
 	}
+
+	return closest
 }
 
 func LevenshteinDistance(source, target string) int {
@@ -88,11 +112,16 @@ func IsSuppressed(
 		return false
 	}
 
+	now := time.Now()
+
 	normalizedFilePath := strings.ReplaceAll(filePath, "\\", "/")
-	for _, suppression := range modelsLinterConfig.Suppressions {
+	for index, suppression := range modelsLinterConfig.Suppressions {
+		if suppression.Expired(now) {
+			continue
+		}
+
 		if Matches(normalizedFilePath, line, rule, suppression, value) {
-			log.Debugf("SUPPRESSED: %s at line %d in %s (reason: %s)",
-				rule, line, filePath, suppression.Reason)
+			modelsLinterConfig.MarkSuppressionUsed(index)
 
 			return true
 		}
@@ -101,13 +130,29 @@ func IsSuppressed(
 	return false
 }
 
-func IsSuppressedNoValue(
+// IsFindingSuppressed reports whether a configured suppression matches the
+// finding in the given file.
+func IsFindingSuppressed(
 	filePath string,
-	line int,
+	finding models.Finding,
 	modelsLinterConfig *models.LinterConfig,
-	rule string,
 ) bool {
-	return IsSuppressed(filePath, line, modelsLinterConfig, rule, "")
+	return IsSuppressed(filePath, finding.Line, modelsLinterConfig, finding.RuleID, SuppressionValue(finding))
+}
+
+// SuppressionValue returns the value that the value of a suppression is
+// matched against: the enum value for suspicious-enum-value, the message for
+// enum-values-sorted-alphabetically and the schema coordinate for the other
+// rules.
+func SuppressionValue(finding models.Finding) string {
+	switch finding.RuleID {
+	case RuleSuspiciousEnumValue:
+		return finding.Coordinate[strings.LastIndex(finding.Coordinate, ".")+1:]
+	case RuleEnumValuesSortedAlphabetically:
+		return finding.Message
+	default:
+		return finding.Coordinate
+	}
 }
 
 func Matches(
@@ -121,13 +166,16 @@ func Matches(
 	normalizedFilePath := strings.ReplaceAll(filePath, "\\", "/")
 
 	fileMatches := modelsSuppression.File == "" ||
-		strings.HasSuffix(normalizedFilePath, normalizedSuppressionFile)
+		MatchPath(normalizedSuppressionFile, normalizedFilePath)
 	lineMatches := modelsSuppression.Line == 0 || modelsSuppression.Line == line
-	ruleMatches := modelsSuppression.Rule == "" || modelsSuppression.Rule == rule
+	ruleMatches := len(modelsSuppression.Rule) == 0 ||
+		slices.ContainsFunc(modelsSuppression.Rule, func(reference string) bool {
+			return RuleMatches(reference, rule)
+		})
 
 	valueMatches := true
 	if modelsSuppression.Value != "" {
-		valueMatches = modelsSuppression.Value == value
+		valueMatches = matchValue(modelsSuppression.Value, value)
 	}
 
 	return fileMatches && lineMatches && ruleMatches && valueMatches
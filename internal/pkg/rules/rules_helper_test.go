@@ -2,6 +2,7 @@ package rules
 
 import (
 	"testing"
+	"time"
 
 	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
 )
@@ -67,11 +68,25 @@ func TestLevenshteinDistance_ExtraCases(t *testing.T) {
 	}
 }
 
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"types-have-descriptions", "fields-have-descriptions"}
+
+	if got := ClosestMatch("field-have-description", candidates); got != "fields-have-descriptions" {
+		t.Errorf("got %q, want fields-have-descriptions", got)
+	}
+
+	if got := ClosestMatch("enum-values-all-caps", candidates); got != "" {
+		t.Errorf("got %q, want no match", got)
+	}
+}
+
 func TestIsSuppressed(t *testing.T) {
 	t.Parallel()
 
 	suppressionConfig := createSuppressionConfig([]models.Suppression{
-		{File: "foo.graphql", Line: 2, Rule: "rule", Value: "val"},
+		{File: "foo.graphql", Line: 2, Rule: models.RuleList{"rule"}, Value: "val"},
 	})
 
 	got := IsSuppressed("bar/foo.graphql", 2, suppressionConfig, "rule", "val")
@@ -85,6 +100,43 @@ func TestIsSuppressed(t *testing.T) {
 	}
 }
 
+func TestIsSuppressed_TracksUsedSuppressions(t *testing.T) {
+	t.Parallel()
+
+	suppressionConfig := createSuppressionConfig([]models.Suppression{
+		{File: "foo.graphql", Rule: models.RuleList{"rule"}},
+		{File: "stale.graphql", Rule: models.RuleList{"rule"}},
+	})
+
+	if suppressionConfig.Suppressions[0].Used() || suppressionConfig.Suppressions[1].Used() {
+		t.Errorf("expected both suppressions to be unused before linting")
+	}
+
+	IsSuppressed("bar/foo.graphql", 2, suppressionConfig, "rule", "")
+
+	if !suppressionConfig.Suppressions[0].Used() || suppressionConfig.Suppressions[1].Used() {
+		t.Errorf("expected only the first suppression to be used")
+	}
+}
+
+func TestIsSuppressed_IgnoresExpiredSuppressions(t *testing.T) {
+	t.Parallel()
+
+	suppressionConfig := createSuppressionConfig([]models.Suppression{
+		{Rule: models.RuleList{"rule"}, Until: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	})
+
+	if IsSuppressed("foo.graphql", 2, suppressionConfig, "rule", "") {
+		t.Errorf("expected an expired suppression not to match")
+	}
+
+	suppressionConfig.Suppressions[0].Until = time.Now().AddDate(1, 0, 0)
+
+	if !IsSuppressed("foo.graphql", 2, suppressionConfig, "rule", "") {
+		t.Errorf("expected a suppression that has not expired to match")
+	}
+}
+
 func createSuppressionConfig(suppressions []models.Suppression) *models.LinterConfig {
 	return &models.LinterConfig{
 		Suppressions: suppressions,
@@ -126,16 +178,25 @@ func TestMatches(t *testing.T) {
 		{"line no match", models.Suppression{Line: 3}, "foo.graphql", 2, "rule", "value", false},
 		{
 			"rule match",
-			models.Suppression{Rule: "myrule"},
+			models.Suppression{Rule: models.RuleList{"myrule"}},
 			"foo.graphql",
 			1,
 			"myrule",
 			"value",
 			true,
 		},
+		{
+			"previous rule ID match",
+			models.Suppression{Rule: models.RuleList{RuleInvalidGraphQLSchema}},
+			"foo.graphql",
+			1,
+			RuleMissingQueryRootType,
+			"Query",
+			true,
+		},
 		{
 			"rule no match",
-			models.Suppression{Rule: "otherrule"},
+			models.Suppression{Rule: models.RuleList{"otherrule"}},
 			"foo.graphql",
 			1,
 			"myrule",
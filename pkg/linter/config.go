@@ -0,0 +1,176 @@
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+)
+
+var (
+	errConfigExtendsCycle = errors.New("configuration extends itself")
+	errUnknownLevel       = errors.New("unknown level")
+	errUnknownRule        = errors.New("unknown rule")
+)
+
+// configSet holds the configurations of a run, converted once each, so that a
+// configuration that applies to several sources, or that is extended, tracks
+// which of its suppressions are used in one place.
+type configSet struct {
+	byConfig map[*Config]*models.LinterConfig
+	byName   map[string]*models.LinterConfig
+	order    []*models.LinterConfig
+}
+
+func newConfigSet() *configSet {
+	return &configSet{
+		byConfig: make(map[*Config]*models.LinterConfig),
+		byName:   make(map[string]*models.LinterConfig),
+	}
+}
+
+// add returns the converted configuration, converting it and the ones it
+// extends first if needed.
+func (s *configSet) add(config *Config) (*models.LinterConfig, error) {
+	if converted, ok := s.byConfig[config]; ok {
+		if converted == nil {
+			return nil, fmt.Errorf("%w: %s", errConfigExtendsCycle, config.Name)
+		}
+
+		return converted, nil
+	}
+
+	if converted, ok := s.byName[config.Name]; ok {
+		s.byConfig[config] = converted
+
+		return converted, nil
+	}
+
+	err := config.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	s.byConfig[config] = nil
+
+	base := &models.LinterConfig{}
+	if config.Extends != nil {
+		base, err = s.add(config.Extends)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	converted := base.Inherit(config.linterConfig())
+
+	s.byConfig[config] = converted
+	if config.Name != "" {
+		s.byName[config.Name] = converted
+	}
+
+	s.order = append(s.order, converted)
+
+	return converted, nil
+}
+
+// sorted returns the configurations ordered by name.
+func (s *configSet) sorted() []*models.LinterConfig {
+	configs := slices.Clone(s.order)
+	slices.SortStableFunc(configs, func(a, b *models.LinterConfig) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return configs
+}
+
+// validate checks that the rules and suppressions of the configuration refer
+// to known rules.
+func (c *Config) validate() error {
+	for id, level := range c.Rules {
+		err := validateRule(id)
+		if err != nil {
+			return fmt.Errorf("invalid rules in configuration '%s': %w", c.Name, err)
+		}
+
+		switch level {
+		case LevelOff, LevelWarn, LevelError:
+		default:
+			return fmt.Errorf("invalid rules in configuration '%s': %w '%s' for '%s'", c.Name, errUnknownLevel, level, id)
+		}
+	}
+
+	for index, suppression := range c.Suppressions {
+		for _, id := range suppression.Rules {
+			err := validateRule(id)
+			if err != nil {
+				return fmt.Errorf("invalid suppressions[%d] in configuration '%s': %w", index, c.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRule(id string) error {
+	if _, ok := registry.Lookup(id); ok {
+		return nil
+	}
+
+	ids := make([]string, 0, len(registry.Rules()))
+	for _, rule := range registry.Rules() {
+		ids = append(ids, rule.ID)
+	}
+
+	if closest := pkg_rules.ClosestMatch(id, ids); closest != "" {
+		return fmt.Errorf("%w '%s', did you mean '%s'?", errUnknownRule, id, closest)
+	}
+
+	return fmt.Errorf("%w '%s'", errUnknownRule, id)
+}
+
+func (c *Config) linterConfig() *models.LinterConfig {
+	rules := make(map[string]models.RuleLevel, len(c.Rules))
+	for id, level := range c.Rules {
+		rules[id] = models.RuleLevel(level)
+	}
+
+	suppressions := make([]models.Suppression, 0, len(c.Suppressions))
+	for _, suppression := range c.Suppressions {
+		suppressions = append(suppressions, models.Suppression{
+			File:   suppression.File,
+			Line:   suppression.Line,
+			Rule:   models.RuleList(slices.Clone(suppression.Rules)),
+			Value:  suppression.Value,
+			Reason: suppression.Reason,
+			Until:  suppression.Until,
+			Owner:  suppression.Owner,
+		})
+	}
+
+	return &models.LinterConfig{
+		Path: c.Name,
+		Settings: models.Settings{
+			StrictMode:               c.Settings.StrictMode,
+			ValidateFederation:       c.Settings.ValidateFederation,
+			CheckDescriptions:        c.Settings.CheckDescriptions,
+			ReportUnusedSuppressions: c.Settings.ReportUnusedSuppressions,
+			RequireSuppressionReason: c.Settings.RequireSuppressionReason,
+		},
+		Rules:        rules,
+		Suppressions: suppressions,
+	}
+}
+
+func newSettings(settings models.Settings) Settings {
+	return Settings{
+		StrictMode:               settings.StrictMode,
+		ValidateFederation:       settings.ValidateFederation,
+		CheckDescriptions:        settings.CheckDescriptions,
+		ReportUnusedSuppressions: settings.ReportUnusedSuppressions,
+		RequireSuppressionReason: settings.RequireSuppressionReason,
+	}
+}
@@ -0,0 +1,181 @@
+package linter
+
+import (
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/rules"
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/registry"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/wundergraph/graphql-go-tools/v2/pkg/astparser"
+)
+
+// lintedFindings are the findings of a schema once the suppressions and rule
+// levels have been applied.
+type lintedFindings struct {
+	reported   []models.Finding
+	suppressed []models.Finding
+}
+
+// lintSource runs the enabled rules on a source.
+func lintSource(source Source, config *models.LinterConfig) lintedFindings {
+	if source.Err != nil {
+		return suppress([]models.Finding{readFailure(source)}, config, noInlineSuppressions)
+	}
+
+	schema := string(source.SDL)
+
+	findings := check(schema, config)
+	for i := range findings {
+		findings[i].FilePath = source.Name
+	}
+
+	inlineSuppressions := pkg_rules.ParseInlineSuppressions(schema)
+
+	return suppress(findings, config, func(string) pkg_rules.InlineSuppressions {
+		return inlineSuppressions
+	})
+}
+
+// lintMerged runs the enabled rules on the sources as one schema. Suppressions,
+// both configured and inline, refer to the original sources, so they are
+// applied once the findings have been attributed back to the source and line
+// they came from. The findings of schema-wide rules, such as a missing PageInfo
+// type, belong to none of the sources and have no file or line. Sources that
+// could not be read are left out of the schema.
+func lintMerged(sources []Source, config *models.LinterConfig) lintedFindings {
+	names := make([]string, 0, len(sources))
+	schemas := make([]string, 0, len(sources))
+
+	var readFailures []models.Finding
+
+	for _, source := range sources {
+		if source.Err != nil {
+			readFailures = append(readFailures, readFailure(source))
+
+			continue
+		}
+
+		names = append(names, source.Name)
+		schemas = append(schemas, string(source.SDL))
+	}
+
+	if len(names) == 0 {
+		return suppress(readFailures, config, noInlineSuppressions)
+	}
+
+	mergedSchema := data.MergeSchemas(names, schemas)
+
+	findings := check(mergedSchema.Source, &models.LinterConfig{Settings: config.Settings, Rules: config.Rules})
+
+	for i, finding := range findings {
+		if rule, ok := registry.Lookup(finding.RuleID); ok && rule.SchemaWide {
+			findings[i] = models.Finding{
+				RuleID:     finding.RuleID,
+				Severity:   finding.Severity,
+				Coordinate: finding.Coordinate,
+				Message:    finding.Message,
+				Suggestion: finding.Suggestion,
+			}
+
+			continue
+		}
+
+		lineOffset := finding.EndLine - finding.Line
+		findings[i].FilePath, findings[i].Line = mergedSchema.Locate(finding.Line)
+
+		if finding.EndLine > 0 {
+			findings[i].EndLine = findings[i].Line + lineOffset
+		}
+	}
+
+	inlineSuppressions := make(map[string]pkg_rules.InlineSuppressions)
+
+	return suppress(append(readFailures, findings...), config, func(name string) pkg_rules.InlineSuppressions {
+		suppressions, ok := inlineSuppressions[name]
+		if !ok {
+			suppressions = pkg_rules.ParseInlineSuppressions(mergedSchema.FileSource(name))
+			inlineSuppressions[name] = suppressions
+		}
+
+		return suppressions
+	})
+}
+
+// readFailure reports a source that could not be read.
+func readFailure(source Source) models.Finding {
+	return models.Finding{
+		FilePath: source.Name,
+		RuleID:   pkg_rules.RuleFailedToReadSchemaFile,
+		Severity: models.SeverityError,
+		Message:  "Unable to read the schema: " + source.Err.Error(),
+	}
+}
+
+// noInlineSuppressions is used for sources without a schema to take inline
+// suppressions from.
+func noInlineSuppressions(string) pkg_rules.InlineSuppressions {
+	return pkg_rules.InlineSuppressions{}
+}
+
+// check parses a schema and runs the enabled rules on it. The rules do not run
+// on a schema that cannot be parsed, as they would report the problems of the
+// part that could be parsed, such as a missing Query type that is defined after
+// the syntax error. The errors of parsing it are reported instead.
+func check(schema string, config *models.LinterConfig) []models.Finding {
+	doc, parseReport := astparser.ParseGraphqlDocumentString(schema)
+	if parseReport.HasErrors() {
+		return rules.ParseErrors(schema, &parseReport)
+	}
+
+	return registry.Run(registry.Context{
+		Document: &doc,
+		Schema:   schema,
+		Lines:    rules.NewLineIndex(doc.Input.RawBytes),
+		Config:   config,
+	})
+}
+
+// suppress drops the findings that a suppression in the configuration or in
+// the schema itself covers, and applies the rule levels to the others.
+func suppress(
+	findings []models.Finding,
+	config *models.LinterConfig,
+	inlineSuppressions func(name string) pkg_rules.InlineSuppressions,
+) lintedFindings {
+	var linted lintedFindings
+
+	for _, finding := range findings {
+		if pkg_rules.IsFindingSuppressed(finding.FilePath, finding, config) ||
+			inlineSuppressions(finding.FilePath).IsSuppressed(finding.Line, finding.RuleID) {
+			linted.suppressed = append(linted.suppressed, finding)
+
+			continue
+		}
+
+		linted.reported = append(linted.reported, finding)
+	}
+
+	linted.reported = applyRuleLevels(linted.reported, config)
+
+	return linted
+}
+
+// applyRuleLevels drops the findings of rules that are turned off and lowers
+// the findings of rules configured as warn to warnings.
+func applyRuleLevels(findings []models.Finding, config *models.LinterConfig) []models.Finding {
+	leveled := make([]models.Finding, 0, len(findings))
+
+	for _, finding := range findings {
+		switch registry.Level(config, finding.RuleID) {
+		case models.RuleLevelOff:
+			continue
+		case models.RuleLevelWarn:
+			finding.Severity = models.SeverityWarning
+		case models.RuleLevelError:
+		}
+
+		leveled = append(leveled, finding)
+	}
+
+	return leveled
+}
@@ -0,0 +1,133 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		schema         string
+		wantSubstring  string
+		wantNbFindings int
+	}{
+		{
+			name:           "missing Query root type",
+			schema:         "type User { id: ID }",
+			wantSubstring:  "missing-query-root-type",
+			wantNbFindings: 5,
+		},
+		{
+			name:           "missing descriptions",
+			schema:         "type Query { id: ID }",
+			wantSubstring:  "Object type 'Query' is missing a description",
+			wantNbFindings: 3,
+		},
+		{
+			name:           "missing deprecation reason",
+			schema:         "enum Status {\n  ACTIVE\n  INACTIVE @deprecated\n}",
+			wantSubstring:  "deprecations-have-a-reason",
+			wantNbFindings: 6,
+		},
+		{
+			name:           "missing type description",
+			schema:         "type Query { id: ID }\ntype Foo { bar: String }",
+			wantSubstring:  "Object type 'Foo' is missing a description",
+			wantNbFindings: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings := check(test.schema, models.NewLinterConfig())
+			assert.Len(t, findings, test.wantNbFindings)
+			assert.True(t, containsFinding(findings, test.wantSubstring), "no finding contains %q in %v",
+				test.wantSubstring, findings)
+		})
+	}
+}
+
+func containsFinding(findings []models.Finding, substring string) bool {
+	for _, finding := range findings {
+		if strings.Contains(finding.String(), substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestCheck_ParseErrors(t *testing.T) {
+	t.Parallel()
+
+	findings := check("type Query {\n  id: ID\n  name String\n}\n", nil)
+	require.NotEmpty(t, findings)
+
+	for _, finding := range findings {
+		assert.Equal(t, pkg_rules.RuleInvalidGraphQLSchema, finding.RuleID)
+	}
+
+	assert.Equal(t, 3, findings[0].Line)
+}
+
+func TestSuppress(t *testing.T) {
+	t.Parallel()
+
+	findings := []models.Finding{
+		{FilePath: "a.graphql", RuleID: "fields-have-descriptions", Line: 2, Severity: models.SeverityError},
+		{FilePath: "a.graphql", RuleID: "fields-have-descriptions", Line: 3, Severity: models.SeverityError},
+		{FilePath: "b.graphql", RuleID: "types-have-descriptions", Line: 1, Severity: models.SeverityError},
+		{FilePath: "b.graphql", RuleID: "type-fields-sorted-alphabetically", Line: 1, Severity: models.SeverityError},
+	}
+	config := &models.LinterConfig{
+		Suppressions: []models.Suppression{{File: "b.graphql", Rule: models.RuleList{"types-have-descriptions"}}},
+		Rules:        map[string]models.RuleLevel{"type-fields-sorted-alphabetically": models.RuleLevelWarn},
+	}
+	inlineSuppressions := pkg_rules.ParseInlineSuppressions(
+		"# graphql-linter-disable-next-line fields-have-descriptions\n",
+	)
+
+	linted := suppress(findings, config, func(name string) pkg_rules.InlineSuppressions {
+		if name == "a.graphql" {
+			return inlineSuppressions
+		}
+
+		return pkg_rules.InlineSuppressions{}
+	})
+
+	assert.Equal(t, []models.Finding{
+		{FilePath: "a.graphql", RuleID: "fields-have-descriptions", Line: 3, Severity: models.SeverityError},
+		{FilePath: "b.graphql", RuleID: "type-fields-sorted-alphabetically", Line: 1, Severity: models.SeverityWarning},
+	}, linted.reported)
+	assert.Equal(t, []models.Finding{findings[0], findings[2]}, linted.suppressed)
+	assert.True(t, config.Suppressions[0].Used())
+}
+
+func TestApplyRuleLevels(t *testing.T) {
+	t.Parallel()
+
+	findings := []models.Finding{
+		{RuleID: "types-have-descriptions", Severity: models.SeverityError},
+		{RuleID: "type-fields-sorted-alphabetically", Severity: models.SeverityError},
+		{RuleID: "fields-have-descriptions", Severity: models.SeverityError},
+	}
+	config := &models.LinterConfig{Rules: map[string]models.RuleLevel{
+		"types-have-descriptions":           models.RuleLevelOff,
+		"type-fields-sorted-alphabetically": models.RuleLevelWarn,
+	}}
+
+	assert.Equal(t, []models.Finding{
+		{RuleID: "type-fields-sorted-alphabetically", Severity: models.SeverityWarning},
+		{RuleID: "fields-have-descriptions", Severity: models.SeverityError},
+	}, applyRuleLevels(findings, config))
+}
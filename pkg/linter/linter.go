@@ -0,0 +1,236 @@
+// Package linter lints GraphQL schemas in-process, with the same rules,
+// configuration and suppressions as the graphql-linter command.
+//
+// The package only looks at the sources and the configuration it is given. It
+// does not read files, look for the project root or write log messages.
+package linter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+)
+
+var errMergedSourceConfig = errors.New("sources that are linted as one schema cannot have a configuration of their own")
+
+// Source is a schema to lint.
+type Source struct {
+	// Name identifies the schema in findings and is what the file of a
+	// suppression is matched against, usually the path of the schema file.
+	Name string
+	SDL  []byte
+	// Err is the error of reading the source, if any. A source that could not
+	// be read is not linted but reported as a failed-to-read-schema-file
+	// finding, and the other sources are still linted.
+	Err error
+	// Config applies to this source instead of the configuration passed to
+	// Lint, for example the configuration of the directory of a schema file.
+	Config *Config
+}
+
+// Level configures whether a rule runs and, if it does, the severity of its
+// findings.
+type Level string
+
+const (
+	LevelOff   Level = "off"
+	LevelWarn  Level = "warn"
+	LevelError Level = "error"
+)
+
+// Settings are the settings of a configuration, see the settings section of
+// .graphql-linter.yml.
+type Settings struct {
+	StrictMode               bool
+	ValidateFederation       bool
+	CheckDescriptions        bool
+	ReportUnusedSuppressions bool
+	RequireSuppressionReason bool
+}
+
+// Suppression silences the findings that match all of its non-empty fields,
+// see the suppressions section of .graphql-linter.yml.
+type Suppression struct {
+	File   string
+	Line   int
+	Rules  []string
+	Value  string
+	Reason string
+	Owner  string
+	// Until is the last day on which the suppression applies. It applies
+	// indefinitely when it is zero.
+	Until time.Time
+}
+
+// Config is the configuration of a lint run.
+type Config struct {
+	// Name identifies the configuration, usually the path of its file.
+	// Findings about its suppressions are reported in Name, and
+	// configurations with the same non-empty Name are treated as one.
+	Name         string
+	Settings     Settings
+	Rules        map[string]Level
+	Suppressions []Suppression
+	// Extends is the configuration that this one extends. Its suppressions
+	// apply to the sources of this configuration as well.
+	Extends *Config
+}
+
+// DefaultConfig returns the configuration that the graphql-linter command uses
+// when there is no configuration file.
+func DefaultConfig() Config {
+	return Config{Settings: newSettings(models.NewLinterConfig().Settings)}
+}
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is a problem that a rule found in a source or in the suppressions of
+// a configuration.
+type Finding struct {
+	// File is the Name of the source or, for findings about suppressions, of
+	// the configuration. It is empty for findings about a schema that is
+	// linted as one.
+	File        string
+	Rule        string
+	Severity    Severity
+	Line        int
+	Column      int
+	EndLine     int
+	EndColumn   int
+	Coordinate  string
+	Message     string
+	Suggestion  string
+	LineContent string
+}
+
+func (f Finding) String() string {
+	if f.Rule == "" {
+		return f.Message
+	}
+
+	return f.Rule + ": " + f.Message
+}
+
+// Result is the outcome of a lint run.
+type Result struct {
+	// Findings are the findings that are reported: the findings of the
+	// sources, in the order of the sources, followed by the findings about
+	// suppressions.
+	Findings []Finding
+	// Suppressed are the findings that a suppression silenced.
+	Suppressed []Finding
+	// Notices are findings that are worth mentioning but are not reported,
+	// such as unused suppressions when reportUnusedSuppressions is off.
+	Notices []Finding
+	// Errors is the number of findings that fail the run: the findings with
+	// error severity and, in strict mode, the warnings.
+	Errors int
+}
+
+// Lint lints every source on its own. The error is only about the arguments,
+// such as an unknown rule in a configuration, or a cancelled context.
+func Lint(ctx context.Context, sources []Source, cfg Config) (Result, error) {
+	return lint(ctx, sources, &cfg, false)
+}
+
+// LintMerged lints the sources as one schema, so types may be defined, used and
+// extended in another source. The findings are attributed to the source and
+// line they come from. The sources cannot have a configuration of their own.
+func LintMerged(ctx context.Context, sources []Source, cfg Config) (Result, error) {
+	return lint(ctx, sources, &cfg, true)
+}
+
+func lint(ctx context.Context, sources []Source, cfg *Config, merge bool) (Result, error) {
+	configs := newConfigSet()
+
+	root, err := configs.add(cfg)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var result Result
+
+	if merge {
+		for _, source := range sources {
+			if source.Config != nil {
+				return Result{}, fmt.Errorf("%w: %s", errMergedSourceConfig, source.Name)
+			}
+		}
+
+		err = ctx.Err()
+		if err != nil {
+			return Result{}, fmt.Errorf("lint cancelled: %w", err)
+		}
+
+		result.add(root, lintMerged(sources, root))
+	} else {
+		for _, source := range sources {
+			err = ctx.Err()
+			if err != nil {
+				return Result{}, fmt.Errorf("lint cancelled: %w", err)
+			}
+
+			config := root
+			if source.Config != nil {
+				config, err = configs.add(source.Config)
+				if err != nil {
+					return Result{}, err
+				}
+			}
+
+			result.add(config, lintSource(source, config))
+		}
+	}
+
+	now := time.Now()
+
+	for _, config := range configs.sorted() {
+		findings, notices := suppressionFindings(config, now)
+		result.add(config, lintedFindings{reported: findings})
+		result.Notices = append(result.Notices, newFindings(notices)...)
+	}
+
+	return result, nil
+}
+
+func (r *Result) add(config *models.LinterConfig, findings lintedFindings) {
+	for _, finding := range findings.reported {
+		if config.Fails(finding) {
+			r.Errors++
+		}
+	}
+
+	r.Findings = append(r.Findings, newFindings(findings.reported)...)
+	r.Suppressed = append(r.Suppressed, newFindings(findings.suppressed)...)
+}
+
+func newFindings(findings []models.Finding) []Finding {
+	converted := make([]Finding, 0, len(findings))
+
+	for _, finding := range findings {
+		converted = append(converted, Finding{
+			File:        finding.FilePath,
+			Rule:        finding.RuleID,
+			Severity:    Severity(finding.Severity),
+			Line:        finding.Line,
+			Column:      finding.Column,
+			EndLine:     finding.EndLine,
+			EndColumn:   finding.EndColumn,
+			Coordinate:  finding.Coordinate,
+			Message:     finding.Message,
+			Suggestion:  finding.Suggestion,
+			LineContent: finding.LineContent,
+		})
+	}
+
+	return converted
+}
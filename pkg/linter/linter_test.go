@@ -0,0 +1,396 @@
+package linter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnreadable = errors.New("permission denied")
+
+const describedSchema = `"""Query root."""
+type Query {
+  """The identifier."""
+  id: ID
+}
+`
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	result, err := Lint(context.Background(), []Source{
+		{Name: "valid.graphql", SDL: []byte(describedSchema)},
+		{Name: "broken.graphql", SDL: []byte("type Query {\n  name String\n}\n")},
+	}, DefaultConfig())
+	require.NoError(t, err)
+
+	require.NotEmpty(t, result.Findings)
+	assert.Equal(t, len(result.Findings), result.Errors)
+
+	files := make(map[string]bool)
+	for _, finding := range result.Findings {
+		files[finding.File] = true
+	}
+
+	assert.Equal(t, map[string]bool{"valid.graphql": true, "broken.graphql": true}, files)
+	assert.Equal(t, Finding{
+		File:        "valid.graphql",
+		Rule:        "relay-page-info-spec",
+		Severity:    SeverityError,
+		Line:        1,
+		Coordinate:  "PageInfo",
+		Message:     "A `PageInfo` object type is required as per the Relay spec.",
+		LineContent: `"""Query root."""`,
+	}, result.Findings[0])
+}
+
+func TestLint_WarningsDoNotFail(t *testing.T) {
+	t.Parallel()
+
+	source := Source{Name: "test.graphql", SDL: []byte(`"""Query root."""
+type Query {
+  """Second."""
+  b: ID
+  """First."""
+  a: ID
+}`)}
+	config := Config{Rules: map[string]Level{
+		"relay-page-info-spec":              LevelOff,
+		"type-fields-sorted-alphabetically": LevelWarn,
+	}}
+
+	result, err := Lint(context.Background(), []Source{source}, config)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Errors)
+
+	if assert.Len(t, result.Findings, 1) {
+		assert.Equal(t, "type-fields-sorted-alphabetically", result.Findings[0].Rule)
+		assert.Equal(t, SeverityWarning, result.Findings[0].Severity)
+	}
+
+	config.Settings.StrictMode = true
+
+	result, err = Lint(context.Background(), []Source{source}, config)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Errors)
+}
+
+func TestLint_UndefinedTypesAreWarnings(t *testing.T) {
+	t.Parallel()
+
+	result, err := Lint(context.Background(), []Source{{Name: "a.graphql", SDL: []byte(`"""Query root."""
+type Query {
+  """The current user."""
+  me: User
+}`)}}, Config{Rules: map[string]Level{"relay-page-info-spec": LevelOff}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Errors)
+
+	if assert.Len(t, result.Findings, 1) {
+		assert.Equal(t, "referenced-types-are-defined", result.Findings[0].Rule)
+		assert.Equal(t, SeverityWarning, result.Findings[0].Severity)
+	}
+}
+
+func TestLint_UnreadableSources(t *testing.T) {
+	t.Parallel()
+
+	sources := []Source{
+		{Name: "missing.graphql", Err: errUnreadable},
+		{Name: "valid.graphql", SDL: []byte(describedSchema)},
+	}
+
+	result, err := Lint(context.Background(), sources, Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Errors)
+
+	if assert.Len(t, result.Findings, 2) {
+		assert.Equal(t, Finding{
+			File:     "missing.graphql",
+			Rule:     "failed-to-read-schema-file",
+			Severity: SeverityError,
+			Message:  "Unable to read the schema: permission denied",
+		}, result.Findings[0])
+		assert.Equal(t, "valid.graphql", result.Findings[1].File)
+	}
+
+	result, err = Lint(context.Background(), sources, Config{
+		Rules: map[string]Level{"failed-to-read-schema-file": LevelWarn, "relay-page-info-spec": LevelOff},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Errors)
+
+	result, err = LintMerged(context.Background(), sources, Config{})
+	require.NoError(t, err)
+
+	rules := make([]string, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		rules = append(rules, finding.Rule)
+	}
+
+	assert.Equal(t, []string{"failed-to-read-schema-file", "relay-page-info-spec"}, rules)
+
+	result, err = LintMerged(context.Background(), sources[:1], Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Errors)
+}
+
+func TestLint_Suppressions(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		Name:     ".graphql-linter.yml",
+		Settings: Settings{ReportUnusedSuppressions: true},
+		Suppressions: []Suppression{
+			{File: "a.graphql", Rules: []string{"relay-page-info-spec"}},
+			{File: "stale.graphql", Rules: []string{"relay-page-info-spec"}},
+		},
+	}
+	source := Source{Name: "a.graphql", SDL: []byte(describedSchema)}
+
+	for range 2 {
+		result, err := Lint(context.Background(), []Source{source}, config)
+		require.NoError(t, err)
+
+		assert.Equal(t, []Finding{{
+			File:       ".graphql-linter.yml",
+			Rule:       "unused-suppression",
+			Severity:   SeverityError,
+			Coordinate: "suppressions[1]",
+			Message:    "suppression does not match any finding: file=stale.graphql rule=relay-page-info-spec",
+		}}, result.Findings)
+
+		if assert.Len(t, result.Suppressed, 1) {
+			assert.Equal(t, "relay-page-info-spec", result.Suppressed[0].Rule)
+		}
+	}
+}
+
+// TestLint_SuppressedEnumFindings checks that the findings of the enum rules
+// are suppressed like those of the other rules, so that they are counted as
+// suppressed rather than dropped.
+func TestLint_SuppressedEnumFindings(t *testing.T) {
+	t.Parallel()
+
+	source := Source{Name: "a.graphql", SDL: []byte(`"""Query root."""
+type Query {
+  """The status."""
+  status: Status
+}
+
+"""A status."""
+enum Status {
+  """Inactive."""
+  INACTIVE1
+  """Active."""
+  ACTIVE
+}`)}
+	config := Config{
+		Rules: map[string]Level{"relay-page-info-spec": LevelOff},
+		Suppressions: []Suppression{
+			{File: "a.graphql", Rules: []string{"enum-values-sorted-alphabetically"}},
+			{File: "a.graphql", Rules: []string{"suspicious-enum-value"}, Value: "INACTIVE1"},
+		},
+	}
+
+	result, err := Lint(context.Background(), []Source{source}, config)
+	require.NoError(t, err)
+	assert.Empty(t, result.Findings)
+
+	var rules []string
+	for _, finding := range result.Suppressed {
+		rules = append(rules, finding.Rule)
+	}
+
+	assert.ElementsMatch(t, []string{"enum-values-sorted-alphabetically", "suspicious-enum-value"}, rules)
+}
+
+// TestLint_SourceConfig checks that a suppression that a configuration inherits
+// counts as used for the configuration it comes from.
+func TestLint_SourceConfig(t *testing.T) {
+	t.Parallel()
+
+	root := Config{
+		Name:     ".graphql-linter.yml",
+		Settings: Settings{ReportUnusedSuppressions: true},
+		Suppressions: []Suppression{
+			{File: "sub/a.graphql", Rules: []string{"relay-page-info-spec"}},
+		},
+	}
+	nested := &Config{
+		Name:         "sub/.graphql-linter.yml",
+		Settings:     Settings{ReportUnusedSuppressions: true},
+		Suppressions: []Suppression{{Rules: []string{"fields-are-camel-cased"}}},
+		Extends:      &root,
+	}
+
+	result, err := Lint(context.Background(), []Source{
+		{Name: "b.graphql", SDL: []byte(describedSchema)},
+		{Name: "sub/a.graphql", SDL: []byte(describedSchema), Config: nested},
+	}, root)
+	require.NoError(t, err)
+
+	var rules, files []string
+	for _, finding := range result.Findings {
+		rules = append(rules, finding.Rule)
+		files = append(files, finding.File)
+	}
+
+	assert.Equal(t, []string{"relay-page-info-spec", "unused-suppression"}, rules)
+	assert.Equal(t, []string{"b.graphql", "sub/.graphql-linter.yml"}, files)
+}
+
+func TestLint_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cycle := &Config{Name: "a.yml"}
+	cycle.Extends = &Config{Name: "b.yml", Extends: cycle}
+
+	tests := []struct {
+		name   string
+		config Config
+		source Source
+		want   string
+	}{
+		{
+			name:   "unknown rule",
+			config: Config{Rules: map[string]Level{"field-have-descriptions": LevelOff}},
+			want:   "unknown rule 'field-have-descriptions', did you mean 'fields-have-descriptions'?",
+		},
+		{
+			name:   "unknown level",
+			config: Config{Rules: map[string]Level{"fields-have-descriptions": "fatal"}},
+			want:   "unknown level 'fatal' for 'fields-have-descriptions'",
+		},
+		{
+			name:   "unknown rule in a suppression",
+			config: Config{Suppressions: []Suppression{{Rules: []string{"no-such-rule"}}}},
+			want:   "invalid suppressions[0] in configuration '': unknown rule 'no-such-rule'",
+		},
+		{
+			name:   "extends cycle",
+			source: Source{Name: "a.graphql", Config: cycle},
+			want:   "configuration extends itself: a.yml",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Lint(context.Background(), []Source{test.source}, test.config)
+			assert.ErrorContains(t, err, test.want)
+		})
+	}
+}
+
+func TestLint_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Lint(ctx, []Source{{Name: "a.graphql", SDL: []byte(describedSchema)}}, DefaultConfig())
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLintMerged(t *testing.T) {
+	t.Parallel()
+
+	userSchema := `"""Query root."""
+type Query {
+  """The current user."""
+  me: User
+}
+
+"""A user."""
+type User {
+  """The identifier."""
+  id: ID
+}`
+	orderSchema := `"""An order."""
+type Order {
+  """The buyer."""
+  buyer: User
+}
+
+extend type Query {
+  """All orders."""
+  orders: [Order]
+}`
+	sources := []Source{
+		{Name: "order.graphql", SDL: []byte(orderSchema)},
+		{Name: "user.graphql", SDL: []byte(userSchema)},
+	}
+
+	result, err := LintMerged(context.Background(), sources, Config{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Errors)
+
+	assert.Equal(t, []Finding{{
+		Rule:       "relay-page-info-spec",
+		Severity:   SeverityError,
+		Coordinate: "PageInfo",
+		Message:    "A `PageInfo` object type is required as per the Relay spec.",
+	}}, result.Findings)
+
+	result, err = LintMerged(context.Background(), sources, Config{
+		Suppressions: []Suppression{{Rules: []string{"relay-page-info-spec"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Errors)
+}
+
+func TestLintMerged_AttributesFindings(t *testing.T) {
+	t.Parallel()
+
+	result, err := LintMerged(context.Background(), []Source{
+		{Name: "a.graphql", SDL: []byte("type Query {\n  id: ID\n}")},
+		{Name: "b.graphql", SDL: []byte("type Foo {\n  bar_baz: String\n}")},
+	}, Config{})
+	require.NoError(t, err)
+
+	for _, finding := range result.Findings {
+		if finding.Rule == "fields-are-camel-cased" {
+			assert.Equal(t, "b.graphql", finding.File)
+			assert.Equal(t, 2, finding.Line)
+			assert.Equal(t, 2, finding.EndLine)
+
+			return
+		}
+	}
+
+	t.Errorf("expected a fields-are-camel-cased finding, got %v", result.Findings)
+}
+
+func TestLintMerged_InlineSuppressionsStayInTheirFile(t *testing.T) {
+	t.Parallel()
+
+	result, err := LintMerged(context.Background(), []Source{
+		{Name: "a.graphql", SDL: []byte("# graphql-linter-disable fields-are-camel-cased\ntype Query {\n  id_a: ID\n}")},
+		{Name: "b.graphql", SDL: []byte("type Foo {\n  bar_baz: String\n}")},
+	}, Config{})
+	require.NoError(t, err)
+
+	var files []string
+
+	for _, finding := range result.Findings {
+		if finding.Rule == "fields-are-camel-cased" {
+			files = append(files, finding.File)
+		}
+	}
+
+	assert.Equal(t, []string{"b.graphql"}, files)
+}
+
+func TestLintMerged_SourceConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := LintMerged(context.Background(), []Source{
+		{Name: "a.graphql", SDL: []byte(describedSchema), Config: &Config{}},
+	}, Config{})
+	require.ErrorIs(t, err, errMergedSourceConfig)
+}
@@ -0,0 +1,101 @@
+package linter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	pkg_rules "github.com/schubergphilis/graphql-linter/internal/pkg/rules"
+)
+
+// suppressionFindings reports the suppressions of a configuration that have
+// expired, that did not match any finding, and the ones without a reason when
+// requireSuppressionReason is set. Unused suppressions are only notices unless
+// reportUnusedSuppressions is set, in which case they become unused-suppression
+// findings.
+func suppressionFindings(config *models.LinterConfig, now time.Time) ([]models.Finding, []models.Finding) {
+	var findings, notices []models.Finding
+
+	for index, suppression := range config.DeclaredSuppressions() {
+		switch {
+		case suppression.Expired(now):
+			findings = append(findings, suppressionFinding(
+				config,
+				index,
+				pkg_rules.RuleExpiredSuppression,
+				fmt.Sprintf(
+					"suppression expired on %s: %s",
+					suppression.Until.Format(time.DateOnly),
+					describeSuppression(suppression),
+				),
+			))
+		case !suppression.Used():
+			finding := suppressionFinding(
+				config,
+				index,
+				pkg_rules.RuleUnusedSuppression,
+				"suppression does not match any finding: "+describeSuppression(suppression),
+			)
+
+			if config.Settings.ReportUnusedSuppressions {
+				findings = append(findings, finding)
+			} else {
+				notices = append(notices, finding)
+			}
+		}
+
+		if config.Settings.RequireSuppressionReason && strings.TrimSpace(suppression.Reason) == "" {
+			findings = append(findings, suppressionFinding(
+				config,
+				index,
+				pkg_rules.RuleMissingSuppressionReason,
+				"suppression has no reason: "+describeSuppression(suppression),
+			))
+		}
+	}
+
+	return applyRuleLevels(findings, config), notices
+}
+
+func suppressionFinding(config *models.LinterConfig, index int, ruleID, message string) models.Finding {
+	return models.Finding{
+		FilePath:   config.Path,
+		RuleID:     ruleID,
+		Severity:   models.SeverityError,
+		Coordinate: fmt.Sprintf("suppressions[%d]", index),
+		Message:    message,
+	}
+}
+
+// describeSuppression lists the fields that a suppression filters on.
+func describeSuppression(suppression models.Suppression) string {
+	var fields []string
+
+	if suppression.File != "" {
+		fields = append(fields, "file="+suppression.File)
+	}
+
+	if suppression.Line != 0 {
+		fields = append(fields, "line="+strconv.Itoa(suppression.Line))
+	}
+
+	if len(suppression.Rule) > 0 {
+		fields = append(fields, "rule="+strings.Join(suppression.Rule, ","))
+	}
+
+	if suppression.Value != "" {
+		fields = append(fields, "value="+suppression.Value)
+	}
+
+	if suppression.Owner != "" {
+		fields = append(fields, "owner="+suppression.Owner)
+	}
+
+	if len(fields) == 0 {
+		return "(matches everything)"
+	}
+
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,104 @@
+package linter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/schubergphilis/graphql-linter/internal/app/graphql-linter/data/base/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressionFindings(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(settings models.Settings) *models.LinterConfig {
+		config := &models.LinterConfig{
+			Path: ".graphql-linter.yml",
+			Suppressions: []models.Suppression{
+				{File: "used.graphql", Rule: models.RuleList{"types-have-descriptions"}, Reason: "Legacy."},
+				{File: "stale.graphql", Rule: models.RuleList{"types-have-descriptions"}},
+			},
+			Settings: settings,
+		}
+		config.MarkSuppressionUsed(0)
+
+		return config
+	}
+
+	unused := models.Finding{
+		FilePath:   ".graphql-linter.yml",
+		RuleID:     "unused-suppression",
+		Severity:   models.SeverityError,
+		Coordinate: "suppressions[1]",
+		Message:    "suppression does not match any finding: file=stale.graphql rule=types-have-descriptions",
+	}
+
+	tests := []struct {
+		name         string
+		settings     models.Settings
+		wantFindings []models.Finding
+		wantNotices  []models.Finding
+	}{
+		{
+			name:         "unused suppressions are only notices by default",
+			settings:     models.Settings{},
+			wantFindings: []models.Finding{},
+			wantNotices:  []models.Finding{unused},
+		},
+		{
+			name:         "unused suppressions as findings",
+			settings:     models.Settings{ReportUnusedSuppressions: true},
+			wantFindings: []models.Finding{unused},
+		},
+		{
+			name:     "suppressions without a reason",
+			settings: models.Settings{RequireSuppressionReason: true},
+			wantFindings: []models.Finding{{
+				FilePath:   ".graphql-linter.yml",
+				RuleID:     "missing-suppression-reason",
+				Severity:   models.SeverityError,
+				Coordinate: "suppressions[1]",
+				Message:    "suppression has no reason: file=stale.graphql rule=types-have-descriptions",
+			}},
+			wantNotices: []models.Finding{unused},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, notices := suppressionFindings(newConfig(test.settings), time.Now())
+			assert.Equal(t, test.wantFindings, findings)
+			assert.Equal(t, test.wantNotices, notices)
+		})
+	}
+}
+
+func TestSuppressionFindings_Expired(t *testing.T) {
+	t.Parallel()
+
+	config := &models.LinterConfig{
+		Path: ".graphql-linter.yml",
+		Suppressions: []models.Suppression{{
+			Rule:   models.RuleList{"types-have-descriptions"},
+			Reason: "Fixed in the next release.",
+			Until:  time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC),
+			Owner:  "team-accounts",
+		}},
+		Settings: models.Settings{ReportUnusedSuppressions: true},
+	}
+	config.MarkSuppressionUsed(0)
+
+	findings, _ := suppressionFindings(config, time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC))
+	assert.Empty(t, findings)
+
+	findings, _ = suppressionFindings(config, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, []models.Finding{{
+		FilePath:   ".graphql-linter.yml",
+		RuleID:     "expired-suppression",
+		Severity:   models.SeverityError,
+		Coordinate: "suppressions[0]",
+		Message:    "suppression expired on 2026-12-31: rule=types-have-descriptions owner=team-accounts",
+	}}, findings)
+}
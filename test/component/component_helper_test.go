@@ -554,14 +554,14 @@ func generateSuppressions() []SuppressionEntry {
 			Reason: "suppress for test",
 		},
 		{
-			File:   "test/testdata/graphql/base/invalid/19-invalid-graphql-schema.graphql",
+			File:   "test/testdata/graphql/base/invalid/19-missing-query-root-type.graphql",
 			Line:   1,
-			Rule:   "invalid-graphql-schema",
+			Rule:   "missing-query-root-type",
 			Value:  "",
 			Reason: "suppress for test",
 		},
 		{
-			File:   "test/testdata/graphql/base/invalid/19-invalid-graphql-schema.graphql",
+			File:   "test/testdata/graphql/base/invalid/19-missing-query-root-type.graphql",
 			Line:   1,
 			Rule:   "relay-page-info-spec",
 			Value:  "",
@@ -86,7 +86,7 @@ func TestOutput(t *testing.T) {
 			"input-object-values-are-camel-cased: 1",
 			"input-object-values-have-descriptions: 1",
 			"interface-fields-sorted-alphabetically: 1",
-			"invalid-graphql-schema: 1",
+			"missing-query-root-type: 1",
 			"relay-connection-arguments-spec: 2",
 			"relay-connection-types-spec: 1",
 			"relay-page-info-spec: 12",